@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
 	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector"
 	"github.com/gotodb/gotodb/datatype"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/optimizer"
@@ -15,6 +16,7 @@ import (
 	"github.com/gotodb/gotodb/planner"
 	"github.com/gotodb/gotodb/row"
 	"github.com/gotodb/gotodb/stage"
+	"github.com/gotodb/gotodb/store"
 	"github.com/gotodb/gotodb/util"
 	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
@@ -81,6 +83,7 @@ func Query(w http.ResponseWriter, req *http.Request) {
 
 	//sqlStr := "show COLUMNS from test.test.csv"
 	hint := optimizer.ParseHint(sqlStr)
+	runtime := config.NewRuntime()
 	inputStream := antlr.NewInputStream(sqlStr)
 	lexer := parser.NewSqlLexer(parser.NewCaseChangingStream(inputStream, true))
 	p := parser.NewSqlParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
@@ -91,8 +94,6 @@ func Query(w http.ResponseWriter, req *http.Request) {
 		_, _ = fmt.Fprintf(w, "%v", errListener)
 		return
 	}
-
-	runtime := config.NewRuntime()
 	logicalTree := planner.NewPlanFromSingleStatement(runtime, tree)
 
 	if err := logicalTree.SetMetadata(); err != nil {
@@ -100,24 +101,52 @@ func Query(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if err := optimizer.DeleteRenameNode(logicalTree); err != nil {
+	var (
+		md     *metadata.Metadata
+		reader row.Reader
+		err    error
+		wg     sync.WaitGroup
+	)
+	run := func() (*metadata.Metadata, row.Reader, error) {
+		return runPlan(runtime, hint, logicalTree, &wg)
+	}
+	if commandPlan, ok := logicalTree.(*planner.CommandPlan); ok {
+		md, reader, err = commandPlan.Command.Run()
+	} else if insertPlan, ok := logicalTree.(*planner.InsertPlan); ok && insertPlan.Create != nil {
+		md, reader, err = insertPlan.CreateTable(run)
+	} else {
+		md, reader, err = run()
+	}
+	if err != nil {
 		_, _ = fmt.Fprintf(w, "%v", err)
 		return
 	}
+	writeResult(w, req, md, reader)
+
+	wg.Wait()
+}
+
+// runPlan sends the jobs of a plan to the workers and returns the reader of its rows, wg is done
+// when the workers have run the jobs.
+func runPlan(runtime *config.Runtime, hint optimizer.Hint, logicalTree planner.Plan, wg *sync.WaitGroup) (*metadata.Metadata, row.Reader, error) {
+	if err := optimizer.DeleteRenameNode(logicalTree); err != nil {
+		return nil, nil, err
+	}
 
 	if err := optimizer.FilterColumns(logicalTree, []string{}); err != nil {
-		_, _ = fmt.Fprintf(w, "%v", err)
-		return
+		return nil, nil, err
 	}
 
 	if err := optimizer.PredicatePushDown(logicalTree, []*planner.BooleanExpressionNode{}); err != nil {
-		_, _ = fmt.Fprintf(w, "%v", err)
-		return
+		return nil, nil, err
 	}
 
 	if err := optimizer.ExtractAggFunc(logicalTree); err != nil {
-		_, _ = fmt.Fprintf(w, "%v", err)
-		return
+		return nil, nil, err
+	}
+
+	if err := optimizer.ConnectorPushDown(logicalTree); err != nil {
+		return nil, nil, err
 	}
 
 	partitionNumber := config.Conf.Runtime.ParallelNumber
@@ -126,8 +155,7 @@ func Query(w http.ResponseWriter, req *http.Request) {
 	}
 	stageJobs, err := stage.CreateJob(logicalTree, workerNode, partitionNumber)
 	if err != nil {
-		_, _ = fmt.Fprintf(w, "%v", err)
-		return
+		return nil, nil, err
 	}
 	var (
 		buf        []byte
@@ -140,11 +168,11 @@ func Query(w http.ResponseWriter, req *http.Request) {
 	var grpcConn = make(map[string]pb.WorkerClient)
 	for _, job := range stageJobs {
 		if buf, err = msgpack.Marshal(job); err != nil {
-			break
+			return nil, nil, err
 		}
 
 		if runtimeBuf, err = msgpack.Marshal(runtime); err != nil {
-			break
+			return nil, nil, err
 		}
 		instructions = append(instructions, &pb.Instruction{
 			TaskID:               taskId,
@@ -158,8 +186,7 @@ func Query(w http.ResponseWriter, req *http.Request) {
 		if _, ok := grpcConn[url]; !ok {
 			_grpc, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
 			if err != nil {
-				_, _ = fmt.Fprintf(w, "failed to dial: %v", err)
-				break
+				return nil, nil, fmt.Errorf("failed to dial: %v", err)
 			}
 			grpcConn[url] = pb.NewWorkerClient(_grpc)
 		}
@@ -168,51 +195,52 @@ func Query(w http.ResponseWriter, req *http.Request) {
 	for _, instruction := range instructions {
 		client := grpcConn[instruction.GetLocation().GetRPC()]
 		if _, err = client.SendInstruction(context.Background(), instruction); err != nil {
-			_, _ = fmt.Fprintf(w, "failed to SendInstruction: %v", err)
-			break
+			return nil, nil, fmt.Errorf("failed to SendInstruction: %v", err)
 		}
 	}
-	var wg sync.WaitGroup
 	for _, instruction := range instructions {
 		client := grpcConn[instruction.GetLocation().GetRPC()]
 		wg.Add(1)
 		go func(instruction *pb.Instruction) {
 			defer wg.Done()
-			if _, err = client.Run(context.Background(), instruction.GetLocation()); err != nil {
+			if _, err := client.Run(context.Background(), instruction.GetLocation()); err != nil {
 				logrus.Errorf("failed to Run: %v", err)
 			}
 		}(instruction)
 	}
-	var (
-		msg []byte
-		r   *row.Row
-	)
 	md := &metadata.Metadata{}
 	aggLoc := aggJob.GetLocation()
 
 	conn, err := net.Dial("tcp", aggLoc.GetURL())
 	if err != nil {
-		_, _ = fmt.Fprintf(w, "failed to connect to input channel %v: %v", aggLoc, err)
-		return
+		return nil, nil, fmt.Errorf("failed to connect to input channel %v: %v", aggLoc, err)
 	}
 	bytes, _ := msgpack.Marshal(aggLoc)
 	if _, err = conn.Write(bytes); err != nil {
-		_, _ = fmt.Fprintf(w, "write loc err: %v", err)
-		return
+		return nil, nil, fmt.Errorf("write loc err: %v", err)
 	}
 
 	if err = util.ReadObject(conn, md); err != nil {
-		_, _ = fmt.Fprintf(w, "read md err: %v", err)
-		return
+		return nil, nil, fmt.Errorf("read md err: %v", err)
 	}
 
 	rbReader := row.NewRowsBuffer(md, conn, nil)
+	return md, rbReader.ReadRow, nil
+}
+
+// writeResult writes the rows of a query in the format the accept header asks for.
+func writeResult(w http.ResponseWriter, req *http.Request, md *metadata.Metadata, read row.Reader) {
+	var (
+		msg []byte
+		r   *row.Row
+		err error
+	)
 	accept := req.Header.Get("accept")
 	switch {
 	case strings.Contains(accept, "application/json"):
 		var res []map[string]interface{}
 		for {
-			r, err = rbReader.ReadRow()
+			r, err = read()
 
 			if err == io.EOF {
 				err = nil
@@ -244,7 +272,7 @@ func Query(w http.ResponseWriter, req *http.Request) {
 
 		rbWriter := row.NewRowsBuffer(md, nil, w)
 		for {
-			r, err = rbReader.ReadRow()
+			r, err = read()
 
 			if err == io.EOF {
 				err = nil
@@ -273,7 +301,7 @@ func Query(w http.ResponseWriter, req *http.Request) {
 		msg = append(msg, []byte("\n")...)
 		w.Write(msg)
 		for {
-			r, err = rbReader.ReadRow()
+			r, err = read()
 
 			if err == io.EOF {
 				err = nil
@@ -293,7 +321,6 @@ func Query(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
-	wg.Wait()
 }
 
 func main() {
@@ -301,6 +328,23 @@ func main() {
 	if err := config.Load(*configFile); err != nil {
 		log.Fatal(err)
 	}
+	if err := connector.Configure(); err != nil {
+		log.Fatal(err)
+	}
+	etcd, err := store.NewEtcd(config.NewEtcd())
+	if err != nil {
+		log.Fatal(err)
+	}
+	store.Default = etcd
+	if err = connector.WatchCatalogs(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err = connector.WatchTables(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err = planner.WatchViews(context.Background()); err != nil {
+		log.Fatal(err)
+	}
 	fmt.Println("start gotodb coordinator")
 	workerDiscovery()
 
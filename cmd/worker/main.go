@@ -5,8 +5,10 @@ import (
 	"flag"
 	"fmt"
 	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector"
 	"github.com/gotodb/gotodb/executor"
 	"github.com/gotodb/gotodb/pb"
+	"github.com/gotodb/gotodb/store"
 	"github.com/vmihailenco/msgpack"
 	"go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
@@ -89,6 +91,20 @@ func main() {
 	if err := config.Load(*configFile); err != nil {
 		log.Fatal(err)
 	}
+	if err := connector.Configure(); err != nil {
+		log.Fatal(err)
+	}
+	etcd, err := store.NewEtcd(config.NewEtcd())
+	if err != nil {
+		log.Fatal(err)
+	}
+	store.Default = etcd
+	if err = connector.WatchCatalogs(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err = connector.WatchTables(context.Background()); err != nil {
+		log.Fatal(err)
+	}
 	fmt.Println("start gotodb worker")
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -1,22 +1,41 @@
 package config
 
 import (
+	"fmt"
 	"github.com/sirupsen/logrus"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"gopkg.in/yaml.v3"
 	"log"
 	"os"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Etcd            Etcd            `yaml:"etcd"`
-	Runtime         *Runtime        `yaml:"runtime"`
-	FileConnectors  FileConnectors  `yaml:"file-connector"`
-	HttpConnectors  HttpConnectors  `yaml:"http-connector"`
-	MysqlConnectors MysqlConnectors `yaml:"mysql-connector"`
-	Worker          Worker          `yaml:"worker"`
-	Coordinator     Coordinator     `yaml:"coordinator"`
+	Etcd        Etcd        `yaml:"etcd"`
+	Runtime     *Runtime    `yaml:"runtime"`
+	Catalogs    Catalogs    `yaml:"catalogs"`
+	Worker      Worker      `yaml:"worker"`
+	Coordinator Coordinator `yaml:"coordinator"`
+}
+
+// Catalog is a named catalog of a connector Type, the connector decodes its Properties.
+type Catalog struct {
+	Type       string    `yaml:"type"`
+	Properties yaml.Node `yaml:"properties"`
+}
+type Catalogs map[string]*Catalog
+
+func (c Catalogs) Check() error {
+	for name, catalog := range c {
+		if name == "" || strings.Contains(name, ".") {
+			return fmt.Errorf("catalog name error: %s", name)
+		}
+		if catalog == nil || catalog.Type == "" {
+			return fmt.Errorf("catalog %s has no type", name)
+		}
+	}
+	return nil
 }
 
 type Runtime struct {
@@ -59,17 +78,7 @@ func Load(fileName string) error {
 		return err
 	}
 
-	if err = Conf.FileConnectors.Check(); err != nil {
-		log.Fatalf("%v", err)
-		return err
-	}
-
-	if err = Conf.HttpConnectors.Check(); err != nil {
-		log.Fatalf("%v", err)
-		return err
-	}
-
-	if err = Conf.MysqlConnectors.Check(); err != nil {
+	if err = Conf.Catalogs.Check(); err != nil {
 		log.Fatalf("%v", err)
 		return err
 	}
@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"unicode/utf8"
 )
 
 type FileConnector struct {
@@ -13,6 +15,24 @@ type FileConnector struct {
 	ColumnNames []string `yaml:"column-names"`
 	ColumnTypes []string `yaml:"column-types"`
 	Paths       []string `yaml:"paths"`
+	Compression string   `yaml:"compression"`
+	Recursive   bool     `yaml:"recursive"`
+	Include     []string `yaml:"include"`
+	Exclude     []string `yaml:"exclude"`
+	// the csv dialect, the defaults are those of encoding/csv
+	Delimiter  string  `yaml:"delimiter"`
+	Quote      string  `yaml:"quote"`
+	Comment    string  `yaml:"comment"`
+	SkipHeader bool    `yaml:"skip-header"`
+	Header     bool    `yaml:"header"`
+	NullString *string `yaml:"null-string"`
+	// infer the columns from the first sample-rows rows when column-names is empty
+	InferSchema bool `yaml:"infer-schema"`
+	SampleRows  int  `yaml:"sample-rows"`
+	// the key=value directories of a partitioned table in the order they nest and the types of
+	// their columns, found from the directories if not set
+	PartitionNames []string `yaml:"partition-names"`
+	PartitionTypes []string `yaml:"partition-types"`
 }
 type FileConnectors map[string]*FileConnector
 
@@ -25,6 +45,33 @@ func (c FileConnectors) GetConfig(name string) *FileConnector {
 	return nil
 }
 
+// GetTableConfig returns the config of a table, an exact name wins over a wildcard pattern. A
+// wildcard table name in the config key maps every subdirectory of the configured paths to a
+// table of the same name.
+func (c FileConnectors) GetTableConfig(catalog, schema, table string) *FileConnector {
+	name := strings.Join([]string{catalog, schema, table}, ".")
+	if config, ok := c[name]; ok {
+		res := *config
+		res.Catalog, res.Schema, res.Table = catalog, schema, table
+		return &res
+	}
+	for pattern, config := range c {
+		if !WildcardMatch(name, pattern) {
+			continue
+		}
+		res := *config
+		res.Catalog, res.Schema, res.Table = catalog, schema, table
+		if ns := strings.Split(pattern, "."); strings.ContainsAny(ns[len(ns)-1], "*?") {
+			res.Paths = make([]string, len(config.Paths))
+			for i, path := range config.Paths {
+				res.Paths[i] = filepath.Join(path, table)
+			}
+		}
+		return &res
+	}
+	return nil
+}
+
 func (c FileConnectors) Check() error {
 	for pattern, conf := range c {
 		ns := strings.Split(pattern, ".")
@@ -34,6 +81,21 @@ func (c FileConnectors) Check() error {
 		if len(conf.ColumnNames) != len(conf.ColumnTypes) {
 			return fmt.Errorf("column names (%d) doesn't match column types (%d)", len(conf.ColumnNames), len(conf.ColumnTypes))
 		}
+		if utf8.RuneCountInString(conf.Delimiter) > 1 || utf8.RuneCountInString(conf.Comment) > 1 {
+			return fmt.Errorf("file config %s: delimiter and comment must be a single character", pattern)
+		}
+		if len(conf.Quote) > 1 || conf.Quote != "" && conf.Quote[0] >= utf8.RuneSelf {
+			return fmt.Errorf("file config %s: quote must be a single ascii character", pattern)
+		}
+		if len(conf.PartitionNames) != len(conf.PartitionTypes) {
+			return fmt.Errorf("partition names (%d) doesn't match partition types (%d)", len(conf.PartitionNames), len(conf.PartitionTypes))
+		}
+		if conf.SampleRows < 0 {
+			return fmt.Errorf("file config %s: sample rows must not be negative", pattern)
+		}
+		if conf.Quote != "" && conf.Quote == conf.Delimiter {
+			return fmt.Errorf("file config %s: quote and delimiter must differ", pattern)
+		}
 	}
 	return nil
 }
@@ -3,18 +3,59 @@ package config
 import (
 	"fmt"
 	"strings"
+
+	"github.com/gotodb/gotodb/pkg/jsonpath"
 )
 
 type HttpConnector struct {
-	Catalog      string   `yaml:"catalog"`
-	Schema       string   `yaml:"schema"`
-	Table        string   `yaml:"table"`
-	DataPath     string   `yaml:"data-path"`
-	FilterColumn string   `yaml:"filter-column"`
-	ResultColumn string   `yaml:"result-column"`
-	ColumnNames  []string `yaml:"column-names"`
-	ColumnTypes  []string `yaml:"column-types"`
+	Catalog string `yaml:"catalog"`
+	Schema  string `yaml:"schema"`
+	Table   string `yaml:"table"`
+	// DataPath is the JSONPath of the items in a response, unless the options of a query set one
+	DataPath     string `yaml:"data-path"`
+	FilterColumn string `yaml:"filter-column"`
+	ResultColumn string `yaml:"result-column"`
+	// ColumnPaths maps columns to the JSONPath of their value in an item, such as user.address.city,
+	// the other columns are the top level keys of their name
+	ColumnPaths map[string]string `yaml:"column-paths"`
+	// Headers and Auth are sent with every request, the options of a query add to them
+	Headers     map[string]string `yaml:"headers"`
+	Auth        *HttpAuth         `yaml:"auth"`
+	ColumnNames []string          `yaml:"column-names"`
+	ColumnTypes []string          `yaml:"column-types"`
+	// Sink is where the rows inserted into the table are sent, the table takes no inserts without one
+	Sink *HttpSink `yaml:"sink"`
+}
+
+// HttpAuth authenticates requests, Type is "bearer" with Token, "basic" with Username and Password,
+// or "api-key" with Token in the header Name, X-API-Key by default, or in the query parameter Name
+// if In is "query".
+type HttpAuth struct {
+	Type     string `yaml:"type" json:"type"`
+	Token    string `yaml:"token" json:"token"`
+	Username string `yaml:"username" json:"username"`
+	Password string `yaml:"password" json:"password"`
+	Name     string `yaml:"name" json:"name"`
+	In       string `yaml:"in" json:"in"`
+}
+
+// HttpSink sends inserted rows as json objects keyed by column name, a json array of up to BatchSize
+// rows per request, 100 by default, or a single object per request if BatchSize is 1. Template is a
+// text/template of the request body executed on the rows, or on the row if BatchSize is 1, and has
+// a json function. Timeout and Backoff are in milliseconds. Retries are of 429 responses, and of
+// 5xx responses only with RetryErrors as the server may have stored the rows of a failed request.
+type HttpSink struct {
+	URL         string `yaml:"url"`
+	Method      string `yaml:"method"`
+	ContentType string `yaml:"content-type"`
+	BatchSize   int    `yaml:"batch-size"`
+	Template    string `yaml:"template"`
+	Timeout     int    `yaml:"timeout"`
+	Retries     *int   `yaml:"retries"`
+	Backoff     int    `yaml:"backoff"`
+	RetryErrors bool   `yaml:"retry-errors"`
 }
+
 type HttpConnectors map[string]*HttpConnector
 
 func (c HttpConnectors) GetConfig(name string) *HttpConnector {
@@ -35,6 +76,36 @@ func (c HttpConnectors) Check() error {
 		if len(conf.ColumnNames) != len(conf.ColumnTypes) {
 			return fmt.Errorf("column names (%d) doesn't match column types (%d)", len(conf.ColumnNames), len(conf.ColumnTypes))
 		}
+		if _, err := jsonpath.Compile(conf.DataPath); err != nil {
+			return fmt.Errorf("http config %s: %v", pattern, err)
+		}
+		for _, expression := range conf.ColumnPaths {
+			if _, err := jsonpath.Compile(expression); err != nil {
+				return fmt.Errorf("http config %s: %v", pattern, err)
+			}
+		}
+		if err := conf.Auth.Check(); err != nil {
+			return fmt.Errorf("http config %s: %v", pattern, err)
+		}
+		if conf.Sink != nil && (conf.Sink.URL == "" || conf.Sink.BatchSize < 0) {
+			return fmt.Errorf("http config %s: sink needs an url and a batch size of at least 0", pattern)
+		}
+	}
+	return nil
+}
+
+func (a *HttpAuth) Check() error {
+	if a == nil {
+		return nil
+	}
+	switch a.Type {
+	case "bearer", "basic":
+	case "api-key":
+		if a.In != "" && a.In != "header" && a.In != "query" {
+			return fmt.Errorf("api key in %s, not in header or query", a.In)
+		}
+	default:
+		return fmt.Errorf("unknown auth type %s", a.Type)
 	}
 	return nil
 }
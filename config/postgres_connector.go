@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	_ "github.com/lib/pq"
+)
+
+type PostgresConnector struct {
+	Catalog  string `yaml:"catalog"`
+	Schema   string `yaml:"schema"`
+	Table    string `yaml:"table"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Database string `yaml:"database"`
+	SSLMode  string `yaml:"sslmode"`
+	// MetadataTTL is how many seconds discovered columns are cached, 300 by default
+	MetadataTTL int      `yaml:"metadata-ttl"`
+	ColumnNames []string `yaml:"column-names"`
+	ColumnTypes []string `yaml:"column-types"`
+}
+type PostgresConnectors map[string]*PostgresConnector
+
+// GetConfig returns the config of a table, an exact name wins over a wildcard pattern.
+func (p PostgresConnectors) GetConfig(name string) *PostgresConnector {
+	if config, ok := p[name]; ok {
+		return config
+	}
+	for pattern, config := range p {
+		if WildcardMatch(name, pattern) {
+			return config
+		}
+	}
+	return nil
+}
+
+// DSN is the lib/pq connection url of the database.
+func (c *PostgresConnector) DSN() string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(c.User, c.Password),
+		Host:   c.Host,
+		Path:   "/" + c.Database,
+	}
+	if c.Port != "" {
+		u.Host += ":" + c.Port
+	}
+	sslMode := c.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	u.RawQuery = url.Values{"sslmode": {sslMode}}.Encode()
+	return u.String()
+}
+
+// Check validates the config names, the columns of wildcard and column-less tables are
+// discovered on first use.
+func (p PostgresConnectors) Check() error {
+	for pattern, c := range p {
+		ns := strings.Split(pattern, ".")
+		if len(ns) != 3 {
+			return fmt.Errorf("postgres config name error: %s", pattern)
+		}
+
+		if len(c.ColumnNames) != len(c.ColumnTypes) {
+			return fmt.Errorf("column names (%d) doesn't match column types (%d)", len(c.ColumnNames), len(c.ColumnTypes))
+		}
+
+		if c.MetadataTTL < 0 {
+			return fmt.Errorf("postgres config %s: metadata ttl must not be negative", pattern)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/gotodb/gotodb/datatype"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type SqliteConnector struct {
+	Catalog     string   `yaml:"catalog"`
+	Schema      string   `yaml:"schema"`
+	Table       string   `yaml:"table"`
+	Path        string   `yaml:"path"`
+	ColumnNames []string `yaml:"column-names"`
+	ColumnTypes []string `yaml:"column-types"`
+}
+type SqliteConnectors map[string]*SqliteConnector
+
+func (s SqliteConnectors) GetConfig(name string) *SqliteConnector {
+	for pattern, config := range s {
+		if name == pattern {
+			return config
+		}
+	}
+	return nil
+}
+
+// Check expands the table wildcard of the config names into one config per table of the
+// database file, discovering the columns from sqlite_master and PRAGMA table_info.
+func (s SqliteConnectors) Check() error {
+	for pattern, c := range s {
+		ns := strings.Split(pattern, ".")
+		if len(ns) != 3 {
+			return fmt.Errorf("sqlite config name error: %s", pattern)
+		}
+		if c.Path == "" {
+			return fmt.Errorf("sqlite config %s: path must be set", pattern)
+		}
+
+		if len(c.ColumnNames) != len(c.ColumnTypes) {
+			return fmt.Errorf("column names (%d) doesn't match column types (%d)", len(c.ColumnNames), len(c.ColumnTypes))
+		}
+
+		if len(c.ColumnNames) > 0 {
+			continue
+		}
+
+		if err := s.discover(ns, c); err != nil {
+			return fmt.Errorf("sqlite config %s: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+func (s SqliteConnectors) discover(ns []string, c *SqliteConnector) error {
+	db, err := sql.Open("sqlite3", c.Path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT name FROM sqlite_master WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite_%' ORDER BY name")
+	if err != nil {
+		return err
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err = rows.Scan(&table); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		if WildcardMatch(table, ns[2]) {
+			tables = append(tables, table)
+		}
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		key := fmt.Sprintf("%s.%s.%s", ns[0], ns[1], table)
+		if conf, configured := s[key]; configured && conf != c {
+			// an explicit config of the table wins over a wildcard
+			continue
+		}
+
+		temp := *c
+		temp.Catalog, temp.Schema, temp.Table = ns[0], ns[1], table
+		temp.ColumnNames, temp.ColumnTypes = []string{}, []string{}
+		columns, err := db.Query(`SELECT name, type FROM pragma_table_info(?)`, table)
+		if err != nil {
+			return err
+		}
+		for columns.Next() {
+			var name, columnType string
+			if err = columns.Scan(&name, &columnType); err != nil {
+				_ = columns.Close()
+				return err
+			}
+			temp.ColumnNames = append(temp.ColumnNames, name)
+			temp.ColumnTypes = append(temp.ColumnTypes, datatype.FromSqlite(columnType).String())
+		}
+		_ = columns.Close()
+		if err = columns.Err(); err != nil {
+			return err
+		}
+		s[key] = &temp
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package config
+
+import "sync"
+
+// Tables holds the tables of the catalogs of a connector type keyed by catalog.schema.table
+// patterns. The map is replaced rather than changed in place, so a reader may keep the map it got.
+type Tables[M ~map[string]*T, T any] struct {
+	lock   sync.RWMutex
+	tables M
+}
+
+// Get returns the tables, which must not be changed.
+func (t *Tables[M, T]) Get() M {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.tables
+}
+
+// Set replaces the tables.
+func (t *Tables[M, T]) Set(tables M) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.tables = tables
+}
@@ -0,0 +1,157 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/store"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// catalogPrefix prefixes the store keys of the catalogs created by CREATE CATALOG.
+const catalogPrefix = "catalog/"
+
+// catalogLock guards the catalogs of the config, which are replaced rather than changed in place,
+// so that a reader may keep the map it got. It also orders the changes of the tables of the types.
+var catalogLock sync.RWMutex
+
+// CreateCatalog adds a catalog to every coordinator and worker through the store. The catalog is
+// tried here first, so a catalog the connector can't use isn't stored.
+func CreateCatalog(name string, catalog *config.Catalog, ifNotExists bool) error {
+	if err := (config.Catalogs{name: catalog}).Check(); err != nil {
+		return err
+	}
+	if _, ok := factories[catalog.Type]; !ok {
+		return fmt.Errorf("unknown connector type %s", catalog.Type)
+	}
+	value, err := yaml.Marshal(catalog)
+	if err != nil {
+		return err
+	}
+	for _, existing := range catalogNames() {
+		if existing == name {
+			if ifNotExists {
+				return nil
+			}
+			return fmt.Errorf("catalog %s already exists", name)
+		}
+	}
+
+	if err := applyCatalog(name, catalog); err != nil {
+		removeCatalog(name)
+		return fmt.Errorf("catalog %s: %v", name, err)
+	}
+	created, err := store.Default.Create(catalogPrefix+name, value)
+	if err != nil {
+		removeCatalog(name)
+		return err
+	}
+	if !created {
+		// another statement created it meanwhile, keep the stored one
+		if value, err = store.Default.Get(catalogPrefix + name); err != nil || value == nil {
+			return err
+		}
+		var stored config.Catalog
+		if err = yaml.Unmarshal(value, &stored); err != nil {
+			return err
+		}
+		if err = applyCatalog(name, &stored); err != nil {
+			return err
+		}
+		if !ifNotExists {
+			return fmt.Errorf("catalog %s already exists", name)
+		}
+	}
+	return nil
+}
+
+// DropCatalog removes a catalog created by CreateCatalog. The catalog is removed here at once,
+// the others remove it when they see it deleted from the store.
+func DropCatalog(name string, ifExists bool) error {
+	deleted, err := store.Default.Delete(catalogPrefix + name)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		removeCatalog(name)
+		return nil
+	}
+	if ifExists {
+		return nil
+	}
+	for _, existing := range catalogNames() {
+		if existing == name {
+			return fmt.Errorf("catalog %s is not created by CREATE CATALOG", name)
+		}
+	}
+	return fmt.Errorf("catalog %s does not exist", name)
+}
+
+// WatchCatalogs adds the catalogs of the store and follows their changes until ctx is done.
+func WatchCatalogs(ctx context.Context) error {
+	return store.Default.Watch(ctx, catalogPrefix, func(key string, value []byte) {
+		name := strings.TrimPrefix(key, catalogPrefix)
+		if value == nil {
+			removeCatalog(name)
+			return
+		}
+		var catalog config.Catalog
+		if err := yaml.Unmarshal(value, &catalog); err != nil {
+			logrus.Errorf("catalog %s: %v", name, err)
+			return
+		}
+		if err := applyCatalog(name, &catalog); err != nil {
+			logrus.Errorf("catalog %s: %v", name, err)
+		}
+	})
+}
+
+// applyCatalog adds or replaces a catalog.
+func applyCatalog(name string, catalog *config.Catalog) error {
+	catalogLock.Lock()
+	defer catalogLock.Unlock()
+
+	factory, ok := factories[catalog.Type]
+	if !ok {
+		return fmt.Errorf("unknown connector type %s", catalog.Type)
+	}
+	dropCatalog(name)
+	catalogs := config.Catalogs{name: catalog}
+	for key, c := range config.Conf.Catalogs {
+		if key != name {
+			catalogs[key] = c
+		}
+	}
+	config.Conf.Catalogs = catalogs
+	if factory.Configure == nil || catalog.Properties.Kind == 0 {
+		return nil
+	}
+	return factory.Configure(name, &catalog.Properties)
+}
+
+func removeCatalog(name string) {
+	catalogLock.Lock()
+	defer catalogLock.Unlock()
+	dropCatalog(name)
+}
+
+// dropCatalog removes a catalog from the config and its type, catalogLock must be held.
+func dropCatalog(name string) {
+	if c, ok := config.Conf.Catalogs[name]; ok {
+		if factory, ok := factories[c.Type]; ok && factory.Drop != nil {
+			factory.Drop(name)
+		}
+		catalogs := config.Catalogs{}
+		for key, c := range config.Conf.Catalogs {
+			if key != name {
+				catalogs[key] = c
+			}
+		}
+		config.Conf.Catalogs = catalogs
+	}
+	RefreshMetadata(name)
+}
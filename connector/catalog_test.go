@@ -0,0 +1,113 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/store"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCatalogs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dev.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("CREATE TABLE env (name TEXT)")
+	_ = db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store.Default = store.NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		config.Conf.Catalogs = nil
+		sqliteTables.Set(nil)
+	}()
+	if err = WatchCatalogs(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var properties yaml.Node
+	if err = yaml.Unmarshal([]byte("{schema: main, path: "+path+"}"), &properties); err != nil {
+		t.Fatal(err)
+	}
+	catalog := &config.Catalog{Type: "sqlite", Properties: *properties.Content[0]}
+	if err = CreateCatalog("dev", catalog, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewConnector("dev", "main", "env"); err != nil {
+		t.Fatal(err)
+	}
+	if err = CreateCatalog("dev", catalog, false); err == nil {
+		t.Error("expected an error for an existing catalog")
+	}
+	if err = CreateCatalog("dev", catalog, true); err != nil {
+		t.Error(err)
+	}
+	if err = CreateCatalog("bad", &config.Catalog{Type: "nosql"}, false); err == nil {
+		t.Error("expected an error for an unknown connector type")
+	}
+
+	// a catalog another coordinator created
+	if err = store.Default.Put(catalogPrefix+"dev2", []byte("type: sqlite\nproperties: {schema: main, path: "+path+"}\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewConnector("dev2", "main", "env"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = DropCatalog("dev", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewConnector("dev", "main", "env"); err == nil {
+		t.Error("expected an error for a dropped catalog")
+	}
+	if _, err = NewConnector("dev2", "main", "env"); err != nil {
+		t.Error(err)
+	}
+	if err = DropCatalog("dev", false); err == nil {
+		t.Error("expected an error for a missing catalog")
+	}
+	if err = DropCatalog("dev", true); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDropCatalogUnwatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dev.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec("CREATE TABLE env (name TEXT)")
+	_ = db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the catalogs of the store aren't watched, the statements apply here at once
+	store.Default = store.NewMemory()
+	var properties yaml.Node
+	if err = yaml.Unmarshal([]byte("{schema: main, path: "+path+"}"), &properties); err != nil {
+		t.Fatal(err)
+	}
+	if err = CreateCatalog("dev", &config.Catalog{Type: "sqlite", Properties: *properties.Content[0]}, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewConnector("dev", "main", "env"); err != nil {
+		t.Fatal(err)
+	}
+	if err = DropCatalog("dev", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewConnector("dev", "main", "env"); err == nil {
+		t.Error("expected an error for a dropped catalog")
+	}
+}
@@ -2,8 +2,7 @@ package connector
 
 import (
 	"fmt"
-	"github.com/gotodb/gotodb/config"
-	"github.com/gotodb/gotodb/connector/file"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/partition"
 	"github.com/gotodb/gotodb/pkg/likematcher"
@@ -14,7 +13,12 @@ import (
 type Connector interface {
 	GetMetadata() (*metadata.Metadata, error)
 	GetPartition(parallelNumber int) (*partition.Partition, error)
-	GetReader(file *partition.FileLocation, selectedMD *metadata.Metadata, filters []string) (row.GroupReader, error)
+	// GetReader applies the filters HandledFilters reports as handled, the others are only hints a
+	// reader may use to skip data
+	GetReader(file *partition.FileLocation, selectedMD *metadata.Metadata, filters []*filter.Predicate) (row.GroupReader, error)
+	// HandledFilters reports for every filter whether the readers apply it exactly as the engine
+	// would, the engine evaluates the others on the rows read
+	HandledFilters(filters []*filter.Predicate) []bool
 	Insert(rb *row.RowsBuffer, Columns []string) (affectedRows int64, err error)
 	ShowTables(catalog, schema string, like, escape *string) row.Reader
 	ShowSchemas(catalog string, like, escape *string) row.Reader
@@ -22,55 +26,61 @@ type Connector interface {
 	ShowPartitions(catalog, schema, table string) row.Reader
 }
 
+// StagedInserter is implemented by connectors whose insert tasks stage their rows, so that
+// nothing of a query is visible before CommitInsert or left behind after AbortInsert.
+type StagedInserter interface {
+	StageInsert(rb *row.RowsBuffer, columns []string, token string, task int) (affectedRows int64, err error)
+	CommitInsert(token string) error
+	AbortInsert(token string) error
+}
+
+// Resolver is implemented by connectors that work out their table when planning, such as the
+// files of a table and the columns inferred from them, the scan tasks get the table resolved.
+type Resolver interface {
+	// Resolved encodes the table for the Resolved func of the factory of the connector type
+	Resolved() ([]byte, error)
+}
+
+// NewResolvedConnector returns the connector of a table of a scan task from what its Resolver
+// encoded, or by NewConnector if there is nothing.
+func NewResolvedConnector(catalog, schema, table string, resolved []byte) (Connector, error) {
+	if resolved != nil {
+		catalogLock.RLock()
+		factory, ok := factories[catalogType(catalog)]
+		catalogLock.RUnlock()
+		if ok && factory.Resolved != nil {
+			return factory.Resolved(resolved)
+		}
+	}
+	return NewConnector(catalog, schema, table)
+}
+
+// NewConnector returns the connector of a table by the connector type of its catalog.
 func NewConnector(catalog string, schema string, table string) (Connector, error) {
-	switch catalog {
-	case "test":
-		return NewTestConnector(catalog, schema, table)
-	case "file":
-		return file.NewFileConnector(catalog, schema, table)
-	case "http":
-		return NewHttpConnector(catalog, schema, table)
-	case "mysql":
-		return NewMysqlConnector(catalog, schema, table)
+	catalogLock.RLock()
+	defer catalogLock.RUnlock()
+	if factory, ok := factories[catalogType(catalog)]; ok {
+		return factory.New(catalog, schema, table)
 	}
 	return nil, fmt.Errorf("newConnector failed: table %s.%s.%s not found", catalog, schema, table)
 }
 
 func NewEmptyConnector(catalog string) Connector {
-	switch catalog {
-	case "test":
-		return NewTestConnectorEmpty()
-	case "file":
-		return file.NewFileConnectorEmpty()
-	case "http":
-		return NewHttpConnectorEmpty()
-	case "mysql":
-		return NewMysqlConnectorEmpty()
-	default:
-		return NewTestConnectorEmpty()
+	catalogLock.RLock()
+	defer catalogLock.RUnlock()
+	if factory, ok := factories[catalogType(catalog)]; ok {
+		return factory.Empty()
 	}
+	return NewTestConnectorEmpty()
 }
 
 func ShowCatalogs(like, escape *string) row.Reader {
 	var err error
-	catalogs := map[string]struct{}{}
-	for _, c := range config.Conf.FileConnectors {
-		catalogs[c.Catalog] = struct{}{}
-	}
-
-	for _, c := range config.Conf.HttpConnectors {
-		catalogs[c.Catalog] = struct{}{}
-	}
-
-	for _, c := range config.Conf.MysqlConnectors {
-		catalogs[c.Catalog] = struct{}{}
-	}
-
 	var rs []*row.Row
 
 	matcher, err := likematcher.Compile(*like, *escape)
 	if err == nil {
-		for catalog := range catalogs {
+		for _, catalog := range catalogNames() {
 			if matcher.Match([]byte(catalog)) {
 				r := row.NewRow()
 				r.AppendVals(catalog)
@@ -92,3 +102,9 @@ func ShowCatalogs(like, escape *string) row.Reader {
 		return rs[i-1], nil
 	}
 }
+
+// RefreshMetadata drops the metadata cached for the tables of a catalog, of all catalogs if it is empty.
+func RefreshMetadata(catalog string) {
+	RefreshMysqlMetadata(catalog)
+	RefreshPostgresMetadata(catalog)
+}
@@ -0,0 +1,101 @@
+package file
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"os"
+
+	dsbzip2 "github.com/dsnet/compress/bzip2"
+	"github.com/golang/snappy"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/klauspost/compress/zstd"
+)
+
+// compressedFile reads or writes a file through a (de)compressor, closing both in order.
+type compressedFile struct {
+	io.Reader
+	io.Writer
+	closers []io.Closer
+}
+
+func (f *compressedFile) Close() error {
+	var err error
+	for _, closer := range f.closers {
+		if e := closer.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// OpenFile opens a file for reading, or for appending if not readonly (creating it if missing), wrapping it with the
+// compression of the location or, if it has none, the one of the file extension.
+func OpenFile(file *partition.FileLocation, readonly bool) (io.ReadWriteCloser, error) {
+	var osFile *os.File
+	var err error
+	if readonly {
+		osFile, err = os.Open(file.Location)
+	} else {
+		osFile, err = os.OpenFile(file.Location, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	compression := file.Compression
+	if compression == partition.CompressionNone {
+		compression = partition.PathToCompression(file.Location)
+	}
+	if compression == partition.CompressionNone {
+		return osFile, nil
+	}
+
+	res := &compressedFile{}
+	if readonly {
+		switch compression {
+		case partition.CompressionGzip:
+			var r *gzip.Reader
+			if r, err = gzip.NewReader(osFile); err == io.EOF {
+				res.Reader, err = osFile, nil
+			} else if err == nil {
+				res.Reader, res.closers = r, []io.Closer{r}
+			}
+		case partition.CompressionZstd:
+			var r *zstd.Decoder
+			if r, err = zstd.NewReader(osFile); err == nil {
+				res.Reader, res.closers = r, []io.Closer{r.IOReadCloser()}
+			}
+		case partition.CompressionSnappy:
+			res.Reader = snappy.NewReader(osFile)
+		case partition.CompressionBzip2:
+			res.Reader = bzip2.NewReader(osFile)
+		}
+	} else {
+		// appending starts a new stream, which all the readers above continue into
+		switch compression {
+		case partition.CompressionGzip:
+			w := gzip.NewWriter(osFile)
+			res.Writer, res.closers = w, []io.Closer{w}
+		case partition.CompressionZstd:
+			var w *zstd.Encoder
+			if w, err = zstd.NewWriter(osFile); err == nil {
+				res.Writer, res.closers = w, []io.Closer{w}
+			}
+		case partition.CompressionSnappy:
+			w := snappy.NewBufferedWriter(osFile)
+			res.Writer, res.closers = w, []io.Closer{w}
+		case partition.CompressionBzip2:
+			var w *dsbzip2.Writer
+			if w, err = dsbzip2.NewWriter(osFile, nil); err == nil {
+				res.Writer, res.closers = w, []io.Closer{w}
+			}
+		}
+	}
+	if err != nil {
+		_ = osFile.Close()
+		return nil, err
+	}
+	res.closers = append(res.closers, osFile)
+	return res, nil
+}
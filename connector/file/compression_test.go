@@ -0,0 +1,60 @@
+package file
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+func TestCompressedCSV(t *testing.T) {
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "info", "student", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "name"))
+
+	for _, name := range []string{"student.csv.gz", "student.csv.zst", "student.csv.sz", "student.csv.bz2"} {
+		location := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(location, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		file := partition.NewFileLocation(location, partition.FileTypeCSV)
+
+		// every insert appends a new compressed stream
+		for i := 0; i < 2; i++ {
+			rg := row.NewRowsGroup(md)
+			rg.AppendRowVals(int64(i), "name")
+			var buf bytes.Buffer
+			rb := row.NewRowsBuffer(md, &buf, &buf)
+			if err := rb.Write(rg); err != nil {
+				t.Fatal(err)
+			}
+			if err := rb.Flush(); err != nil {
+				t.Fatal(err)
+			}
+			handler, err := NewHandler(file, md, nil, false, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = handler.Write(rb, nil); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+
+		handler, err := NewHandler(file, md, nil, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rg, err := handler.Read(nil)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if rg.RowsNumber != 2 || rg.Vals[0][0] != int64(0) || rg.Vals[0][1] != int64(1) || rg.Vals[1][1] != "name" {
+			t.Fatalf("%s: unexpected values %v", name, rg.Vals)
+		}
+	}
+}
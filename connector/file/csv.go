@@ -4,8 +4,10 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
-	"os"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/gotodb/gotodb/config"
 	"github.com/gotodb/gotodb/datatype"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/row"
@@ -22,15 +24,101 @@ type CSV struct {
 	Writer      *csv.Writer
 	Indexes     []int
 	OutMetadata *metadata.Metadata
+	// Fields maps a metadata column index to its field in a record, -1 if the file lacks the column,
+	// it is set from the header row and the fields follow the column order without one
+	Fields     []int
+	SkipHeader bool
+	Header     bool
+	NullString *string
+	// WriteHeader writes the column names first, for a new file of a table with a header row
+	WriteHeader bool
+	// Quote replaces the '"' quote character if set
+	Quote   byte
+	started bool
 }
 
-func NewCSV(osFile *os.File, md *metadata.Metadata) *CSV {
-	return &CSV{
+func NewCSV(file io.ReadWriteCloser, md *metadata.Metadata, conf *config.FileConnector) *CSV {
+	res := &CSV{
 		Metadata: md,
-		Reader:   csv.NewReader(osFile),
-		Writer:   csv.NewWriter(osFile),
-		Closer:   osFile,
+		Closer:   file,
 	}
+	var (
+		r io.Reader = file
+		w io.Writer = file
+	)
+	if conf != nil {
+		res.SkipHeader, res.Header, res.NullString = conf.SkipHeader, conf.Header, conf.NullString
+		if conf.Quote != "" && conf.Quote != `"` {
+			// encoding/csv always quotes with '"', swapping it with the quote character in the
+			// stream and in the fields keeps both the quoting and the field contents intact
+			res.Quote = conf.Quote[0]
+			r = &swapReader{Reader: r, a: '"', b: res.Quote}
+			w = &swapWriter{Writer: w, a: '"', b: res.Quote}
+		}
+	}
+
+	res.Reader, res.Writer = csv.NewReader(r), csv.NewWriter(w)
+	if conf != nil {
+		if conf.Delimiter != "" {
+			delimiter, _ := utf8.DecodeRuneInString(conf.Delimiter)
+			res.Reader.Comma, res.Writer.Comma = res.swapRune(delimiter), res.swapRune(delimiter)
+		}
+		if conf.Comment != "" {
+			comment, _ := utf8.DecodeRuneInString(conf.Comment)
+			res.Reader.Comment = res.swapRune(comment)
+		}
+	}
+	return res
+}
+
+func (csv *CSV) swapRune(c rune) rune {
+	switch {
+	case csv.Quote == 0:
+		return c
+	case c == '"':
+		return rune(csv.Quote)
+	case c == rune(csv.Quote):
+		return '"'
+	}
+	return c
+}
+
+func (csv *CSV) swapString(s string) string {
+	if csv.Quote == 0 {
+		return s
+	}
+	return strings.Map(csv.swapRune, s)
+}
+
+// readHeader skips the header row, mapping the columns onto its field names if configured.
+func (csv *CSV) readHeader() error {
+	if csv.started {
+		return nil
+	}
+	csv.started = true
+	if !csv.SkipHeader && !csv.Header {
+		return nil
+	}
+
+	record, err := csv.Reader.Read()
+	if err != nil {
+		return err
+	}
+	if csv.Header {
+		fields := map[string]int{}
+		for i, name := range record {
+			fields[strings.ToLower(strings.TrimSpace(csv.swapString(name)))] = i
+		}
+		csv.Fields = make([]int, csv.Metadata.GetColumnNumber())
+		for i, column := range csv.Metadata.Columns {
+			if field, ok := fields[strings.ToLower(column.ColumnName)]; ok {
+				csv.Fields[i] = field
+			} else {
+				csv.Fields[i] = -1
+			}
+		}
+	}
+	return nil
 }
 
 func (csv *CSV) SetColumns(indexes []int) error {
@@ -67,12 +155,29 @@ func (csv *CSV) Read(indexes []int) (*row.RowsGroup, error) {
 	}
 
 	rg := row.NewRowsGroup(csv.OutMetadata)
+	if err = csv.readHeader(); err != nil {
+		_ = csv.Closer.Close()
+		return nil, err
+	}
 	for r := 0; r < ReadRowsNumber; r++ {
 		if record, err = csv.Reader.Read(); err != nil {
 			break
 		}
 		for i, index := range csv.Indexes {
-			rg.Vals[i] = append(rg.Vals[i], datatype.ToValue(record[index], csv.Metadata.Columns[index].ColumnType))
+			field := index
+			if csv.Fields != nil {
+				field = csv.Fields[index]
+			}
+			if field < 0 || field >= len(record) {
+				rg.Vals[i] = append(rg.Vals[i], nil)
+				continue
+			}
+			value := csv.swapString(record[field])
+			if csv.NullString != nil && value == *csv.NullString {
+				rg.Vals[i] = append(rg.Vals[i], nil)
+			} else {
+				rg.Vals[i] = append(rg.Vals[i], datatype.ToValue(value, csv.Metadata.Columns[index].ColumnType))
+			}
 		}
 		rg.RowsNumber++
 	}
@@ -97,7 +202,19 @@ func (csv *CSV) Write(rb *row.RowsBuffer, indexes []int) (affectedRows int64, er
 		return 0, fmt.Errorf("column does not match")
 	}
 
+	null := ""
+	if csv.NullString != nil {
+		null = *csv.NullString
+	}
 	record := make([]string, len(csv.Metadata.Columns))
+	if csv.WriteHeader {
+		for i, column := range csv.Metadata.Columns {
+			record[i] = csv.swapString(column.ColumnName)
+		}
+		if err = csv.Writer.Write(record); err != nil {
+			return
+		}
+	}
 	var rg *row.RowsGroup
 	for {
 		rg, err = rb.Read()
@@ -108,8 +225,13 @@ func (csv *CSV) Write(rb *row.RowsBuffer, indexes []int) (affectedRows int64, er
 			break
 		}
 		for r := 0; r < rg.RowsNumber; r++ {
+			for i := range record {
+				record[i] = null
+			}
 			for columnNum, index := range csv.Indexes {
-				record[index] = datatype.ToValue(rg.Vals[columnNum][r], datatype.STRING).(string)
+				if value := rg.Vals[columnNum][r]; value != nil {
+					record[index] = csv.swapString(datatype.ToValue(value, datatype.STRING).(string))
+				}
 			}
 			if err = csv.Writer.Write(record); err != nil {
 				return
@@ -128,3 +250,38 @@ func (csv *CSV) Write(rb *row.RowsBuffer, indexes []int) (affectedRows int64, er
 	}
 	return
 }
+
+// swapReader exchanges two bytes in everything read.
+type swapReader struct {
+	io.Reader
+	a, b byte
+}
+
+func (r *swapReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	swapBytes(p[:n], r.a, r.b)
+	return n, err
+}
+
+// swapWriter exchanges two bytes in everything written.
+type swapWriter struct {
+	io.Writer
+	a, b byte
+}
+
+func (w *swapWriter) Write(p []byte) (int, error) {
+	b := make([]byte, len(p))
+	copy(b, p)
+	swapBytes(b, w.a, w.b)
+	return w.Writer.Write(b)
+}
+
+func swapBytes(p []byte, a, b byte) {
+	for i, c := range p {
+		if c == a {
+			p[i] = b
+		} else if c == b {
+			p[i] = a
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+func TestCSVDialect(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "student.tsv")
+	data := "# students\nname\tid\tnote\n'a\tb'\t1\t'say \"hi\" ''x'''\nc\t\\N\t\n"
+	if err := os.WriteFile(location, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "info", "student", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "name"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "note"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "age"))
+	null := `\N`
+	conf := &config.FileConnector{Delimiter: "\t", Quote: "'", Comment: "#", Header: true, NullString: &null}
+	file := partition.NewFileLocation(location, partition.FileTypeCSV)
+
+	read := func() *row.RowsGroup {
+		handler, err := NewHandler(file, md, conf, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rg, err := handler.Read(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = handler.Read(nil); err != io.EOF {
+			t.Fatalf("expected EOF, got %v", err)
+		}
+		return rg
+	}
+
+	rg := read()
+	if rg.RowsNumber != 2 {
+		t.Fatalf("expected 2 rows, got %d", rg.RowsNumber)
+	}
+	if rg.Vals[0][0] != int64(1) || rg.Vals[1][0] != "a\tb" || rg.Vals[2][0] != `say "hi" 'x'` || rg.Vals[3][0] != nil {
+		t.Fatalf("unexpected values %v", rg.Vals)
+	}
+	if rg.Vals[0][1] != nil || rg.Vals[1][1] != "c" || rg.Vals[2][1] != "" {
+		t.Fatalf("unexpected values %v", rg.Vals)
+	}
+
+	// writes follow the column order, so only a header in the same order reads them back
+	if err := os.WriteFile(location, []byte("id\tname\tnote\tage\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	in := row.NewRowsGroup(md.SelectColumnsByIndexes([]int{0, 1}))
+	in.AppendRowVals(int64(2), "it's")
+	in.AppendRowVals(nil, "d")
+	var buf bytes.Buffer
+	rb := row.NewRowsBuffer(in.Metadata, &buf, &buf)
+	if err := rb.Write(in); err != nil {
+		t.Fatal(err)
+	}
+	if err := rb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	handler, err := NewHandler(file, md, conf, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := handler.Write(rb, []int{0, 1}); err != nil || n != 2 {
+		t.Fatalf("unexpected write result %d, %v", n, err)
+	}
+
+	rg = read()
+	if rg.RowsNumber != 2 || rg.Vals[0][0] != int64(2) || rg.Vals[1][0] != "it's" || rg.Vals[0][1] != nil || rg.Vals[2][1] != nil {
+		t.Fatalf("unexpected values %v", rg.Vals)
+	}
+}
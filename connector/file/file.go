@@ -4,41 +4,101 @@ import (
 	"fmt"
 	"github.com/gotodb/gotodb/partition"
 	"io"
-	"math/rand"
 	"os"
+	"path/filepath"
 	"strings"
-	"time"
 
 	"github.com/gotodb/gotodb/config"
 	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/row"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/yaml.v3"
 )
 
 type File struct {
-	Config    *config.FileConnector
-	Metadata  *metadata.Metadata
-	FileType  partition.FileType
-	Partition *partition.Partition
+	Config *config.FileConnector
+	// Metadata holds the data columns followed by the partition columns, DataMetadata only the former
+	Metadata     *metadata.Metadata
+	DataMetadata *metadata.Metadata
+	FileType     partition.FileType
+	Partition    *partition.Partition
 }
 
+// Tables holds the tables of the file catalogs.
+var Tables config.Tables[config.FileConnectors, config.FileConnector]
+
 func NewFileConnectorEmpty() *File {
 	return &File{}
 }
 
 func NewFileConnector(catalog, schema, table string) (*File, error) {
-	var err error
-	res := &File{}
-	key := strings.Join([]string{catalog, schema, table}, ".")
-	conf := config.Conf.FileConnectors.GetConfig(key)
+	conf := Tables.Get().GetTableConfig(catalog, schema, table)
 	if conf == nil {
 		return nil, fmt.Errorf("file connector: table not found")
 	}
+	return NewFileConnectorFromConfig(conf)
+}
+
+// NewFileConnectorFromConfig returns the connector of the table of a config.
+func NewFileConnectorFromConfig(conf *config.FileConnector) (*File, error) {
+	var err error
+	res := &File{}
 	res.Config = conf
 	res.FileType = partition.StringToFileType(conf.FileType)
-	res.Metadata, err = NewFileMetadata(conf)
 
-	return res, err
+	part, err := res.GetPartition(0)
+	if err != nil {
+		return nil, err
+	}
+	if conf.InferSchema && len(conf.ColumnNames) == 0 {
+		var files []*partition.FileLocation
+		if part.IsPartition() {
+			for i := 0; i < part.GetPartitionNum(); i++ {
+				files = append(files, part.GetPartitionFiles(i)...)
+			}
+		} else {
+			files = part.GetNoPartitionFiles()
+		}
+		for i, file := range files {
+			files[i] = res.fileLocation(file)
+		}
+		if err = InferSchema(conf, files); err != nil {
+			return nil, err
+		}
+	}
+
+	if res.DataMetadata, err = NewFileMetadata(conf); err != nil {
+		return nil, err
+	}
+	res.Metadata = res.DataMetadata.Copy()
+	for _, column := range part.Metadata.Columns {
+		res.Metadata.AppendColumn(column.Copy())
+	}
+	return res, nil
+}
+
+// Resolved encodes the config of the table with the inferred columns.
+func (c *File) Resolved() ([]byte, error) {
+	return yaml.Marshal(c.Config)
+}
+
+// NewResolvedFileConnector returns the connector of a scan task from the config Resolved
+// encoded, without looking for the files or inferring the columns again. The files of a scan
+// task come with it.
+func NewResolvedFileConnector(resolved []byte) (*File, error) {
+	conf := &config.FileConnector{}
+	if err := yaml.Unmarshal(resolved, conf); err != nil {
+		return nil, err
+	}
+	var err error
+	res := &File{Config: conf, FileType: partition.StringToFileType(conf.FileType)}
+	if res.DataMetadata, err = NewFileMetadata(conf); err != nil {
+		return nil, err
+	}
+	res.Metadata = res.DataMetadata
+	return res, nil
 }
 
 func NewFileMetadata(conf *config.FileConnector) (*metadata.Metadata, error) {
@@ -64,30 +124,39 @@ func (c *File) GetMetadata() (*metadata.Metadata, error) {
 
 func (c *File) GetPartition(_ int) (*partition.Partition, error) {
 	if c.Partition == nil {
-		c.Partition = partition.New(metadata.NewMetadata())
-		for _, loc := range c.Config.Paths {
-			files, err := os.ReadDir(loc)
-			if err != nil {
-				return nil, err
-			}
-			for _, file := range files {
-				c.Partition.Locations = append(c.Partition.Locations, loc+"/"+file.Name())
-				c.Partition.FileTypes = append(c.Partition.FileTypes, c.FileType)
-			}
+		part, err := ReadPartition(c.Config, c.FileType)
+		if err != nil {
+			return nil, err
 		}
+		c.Partition = part
 	}
 	return c.Partition, nil
 }
 
-func (c *File) GetReader(file *partition.FileLocation, selectedMD *metadata.Metadata, _ []string) (row.GroupReader, error) {
-	reader, err := NewHandler(file, c.Metadata, true)
+// fileLocation applies the configured compression to a file location.
+func (c *File) fileLocation(file *partition.FileLocation) *partition.FileLocation {
+	if c.Config.Compression == "" {
+		return file
+	}
+	res := *file
+	res.Compression = partition.StringToCompression(c.Config.Compression)
+	return &res
+}
+
+// HandledFilters takes none of the filters, the readers only use them to skip data.
+func (c *File) HandledFilters(filters []*filter.Predicate) []bool {
+	return make([]bool, len(filters))
+}
+
+func (c *File) GetReader(file *partition.FileLocation, selectedMD *metadata.Metadata, filters []*filter.Predicate) (row.GroupReader, error) {
+	reader, err := NewHandler(c.fileLocation(file), c.DataMetadata, c.Config, true, filters)
 	if err != nil {
 		return nil, err
 	}
 
 	indexes := make([]int, len(selectedMD.Columns))
 	for i, col := range selectedMD.Columns {
-		indexes[i] = c.Metadata.ColumnMap[col.ColumnName]
+		indexes[i] = c.DataMetadata.ColumnMap[col.ColumnName]
 	}
 
 	return func(_ []int) (*row.RowsGroup, error) {
@@ -96,34 +165,136 @@ func (c *File) GetReader(file *partition.FileLocation, selectedMD *metadata.Meta
 }
 
 func (c *File) Insert(rb *row.RowsBuffer, columns []string) (affectedRows int64, err error) {
+	token := uuid.NewV4().String()
+	if affectedRows, err = c.StageInsert(rb, columns, token, 0); err != nil {
+		return
+	}
+	return affectedRows, c.CommitInsert(token)
+}
 
+// insertDir is the directory new files of a table are written to.
+func (c *File) insertDir() (string, error) {
 	part, err := c.GetPartition(0)
+	if err != nil {
+		return "", err
+	}
+	if part.IsPartition() {
+		return "", fmt.Errorf("file connector: insert into a partitioned table is not supported")
+	}
+	if len(c.Config.Paths) > 0 {
+		if info, err := os.Stat(c.Config.Paths[0]); err == nil && info.IsDir() {
+			return c.Config.Paths[0], nil
+		}
+	}
+	return "", fmt.Errorf("file connector: insert needs a table whose first path is a directory")
+}
+
+// stagedFiles lists the files staged by the insert tasks of a query.
+func (c *File) stagedFiles(token string) (dir string, names []string, err error) {
+	if dir, err = c.insertDir(); err != nil {
+		return
+	}
+	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return
 	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".part-"+token+"-") {
+			names = append(names, entry.Name())
+		}
+	}
+	return
+}
 
-	rand.Seed(time.Now().Unix())
-	n := rand.Intn(len(c.Partition.Locations))
-	writer, err := NewHandler(part.GetNoPartitionFiles()[n], c.Metadata, false)
+// StageInsert writes the rows of an insert task to a new hidden file, which readers skip until
+// CommitInsert renames it.
+func (c *File) StageInsert(rb *row.RowsBuffer, columns []string, token string, task int) (affectedRows int64, err error) {
+	dir, err := c.insertDir()
 	if err != nil {
 		return
 	}
 
+	name := fmt.Sprintf(".part-%s-%05d", token, task)
+	switch c.FileType {
+	case partition.FileTypeCSV:
+		name += ".csv"
+	case partition.FileTypeJSON:
+		name += ".json"
+	}
+	switch partition.StringToCompression(c.Config.Compression) {
+	case partition.CompressionGzip:
+		name += ".gz"
+	case partition.CompressionZstd:
+		name += ".zst"
+	case partition.CompressionSnappy:
+		name += ".snappy"
+	case partition.CompressionBzip2:
+		name += ".bz2"
+	}
+	location := filepath.Join(dir, name)
+	if _, err = os.Stat(location); err == nil {
+		return 0, fmt.Errorf("file connector: staging file %s already exists", location)
+	}
+
+	writer, err := NewHandler(c.fileLocation(partition.NewFileLocation(location, c.FileType)), c.DataMetadata, c.Config, false, nil)
+	if err != nil {
+		return
+	}
+	if csv, ok := writer.(*CSV); ok {
+		csv.WriteHeader = csv.Header || csv.SkipHeader
+	}
+
 	var indexes []int
-	if columns != nil && len(columns) > 0 {
+	if len(columns) > 0 {
 		indexes = make([]int, len(columns))
 		for i, column := range columns {
 			indexes[i] = c.Metadata.ColumnMap[column]
 		}
 	}
 
-	return writer.Write(rb, indexes)
+	if affectedRows, err = writer.Write(rb, indexes); err != nil || affectedRows == 0 {
+		_ = os.Remove(location)
+	}
+	return
+}
+
+// CommitInsert publishes the files staged by the insert tasks of a query. It renames the files one
+// by one, so a commit that fails keeps the files renamed before and removes the others. The files
+// are listed in the first path of the table, which every worker must share with the coordinator.
+func (c *File) CommitInsert(token string) error {
+	dir, names, err := c.stagedFiles(token)
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		if err = os.Rename(filepath.Join(dir, name), filepath.Join(dir, name[1:])); err != nil {
+			for _, name := range names[i:] {
+				_ = os.Remove(filepath.Join(dir, name))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// AbortInsert removes the files staged by the insert tasks of a query.
+func (c *File) AbortInsert(token string) error {
+	dir, names, err := c.stagedFiles(token)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if e := os.Remove(filepath.Join(dir, name)); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
 }
 
 func (c *File) ShowSchemas(catalog string, _, _ *string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key := range config.Conf.FileConnectors {
+	for key := range Tables.Get() {
 		ns := strings.Split(key, ".")
 		c, s, _ := ns[0], ns[1], ns[2]
 		if c == catalog {
@@ -149,12 +320,20 @@ func (c *File) ShowSchemas(catalog string, _, _ *string) row.Reader {
 func (c *File) ShowTables(catalog, schema string, _, _ *string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key := range config.Conf.FileConnectors {
+	tableConfigs := Tables.Get()
+	for key := range tableConfigs {
 		ns := strings.Split(key, ".")
 		c, s, t := ns[0], ns[1], ns[2]
-		if c == catalog && s == schema {
+		if c != catalog || s != schema {
+			continue
+		}
+		tables := []string{t}
+		if strings.ContainsAny(t, "*?") {
+			tables = wildcardTables(tableConfigs[key].Paths, t)
+		}
+		for _, table := range tables {
 			r := row.NewRow()
-			r.AppendVals(t)
+			r.AppendVals(table)
 			rs = append(rs, r)
 		}
 	}
@@ -172,19 +351,31 @@ func (c *File) ShowTables(catalog, schema string, _, _ *string) row.Reader {
 	}
 }
 
+// wildcardTables lists the subdirectories of the paths of a wildcard table entry that match it.
+func wildcardTables(paths []string, pattern string) []string {
+	var tables []string
+	for _, path := range paths {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && !hidden(entry.Name()) && config.WildcardMatch(entry.Name(), pattern) {
+				tables = append(tables, entry.Name())
+			}
+		}
+	}
+	return tables
+}
+
 func (c *File) ShowColumns(catalog, schema, table string) row.Reader {
-	var err error
 	var rs []*row.Row
-	for key, conf := range config.Conf.FileConnectors {
-		ns := strings.Split(key, ".")
-		c, s, t := ns[0], ns[1], ns[2]
-		if c == catalog && s == schema && t == table {
-			for i, name := range conf.ColumnNames {
-				r := row.NewRow()
-				r.AppendVals(name, conf.ColumnTypes[i])
-				rs = append(rs, r)
-			}
-			break
+	conn, err := NewFileConnector(catalog, schema, table)
+	if err == nil {
+		for _, column := range conn.Metadata.Columns {
+			r := row.NewRow()
+			r.AppendVals(column.ColumnName, column.ColumnType.String())
+			rs = append(rs, r)
 		}
 	}
 
@@ -202,8 +393,23 @@ func (c *File) ShowColumns(catalog, schema, table string) row.Reader {
 	}
 }
 
-func (c *File) ShowPartitions(_, _, _ string) row.Reader {
+func (c *File) ShowPartitions(catalog, schema, table string) row.Reader {
+	var part *partition.Partition
+	conn, err := NewFileConnector(catalog, schema, table)
+	if err == nil {
+		part, err = conn.GetPartition(0)
+	}
+
+	i := 0
 	return func() (*row.Row, error) {
-		return nil, io.EOF
+		if err != nil {
+			return nil, err
+		}
+		if i >= part.GetPartitionNum() {
+			return nil, io.EOF
+		}
+
+		i++
+		return part.GetPartitionRow(i - 1), nil
 	}
 }
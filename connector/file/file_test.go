@@ -0,0 +1,155 @@
+package file
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+func TestStagedInsert(t *testing.T) {
+	dir := t.TempDir()
+	conf := &config.FileConnector{FileType: "csv", Paths: []string{dir}, Header: true, Compression: "gzip"}
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "info", "student", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "name"))
+	c := &File{Config: conf, FileType: partition.FileTypeCSV, Metadata: md, DataMetadata: md}
+
+	rows := func(ids ...int64) *row.RowsBuffer {
+		in := row.NewRowsGroup(md)
+		for _, id := range ids {
+			in.AppendRowVals(id, "x")
+		}
+		var buf bytes.Buffer
+		rb := row.NewRowsBuffer(md, &buf, &buf)
+		if err := rb.Write(in); err != nil {
+			t.Fatal(err)
+		}
+		if err := rb.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		return rb
+	}
+	visible := func() []string {
+		part, err := ReadPartition(conf, partition.FileTypeCSV)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return part.Locations
+	}
+
+	for task, ids := range [][]int64{{1, 2}, {3}} {
+		if n, err := c.StageInsert(rows(ids...), nil, "q1", task); err != nil || n != int64(len(ids)) {
+			t.Fatalf("unexpected stage result %d, %v", n, err)
+		}
+	}
+	if _, err := c.StageInsert(rows(4), nil, "q2", 0); err != nil {
+		t.Fatal(err)
+	}
+	if files := visible(); len(files) != 0 {
+		t.Fatalf("staged files are visible: %v", files)
+	}
+
+	if err := c.CommitInsert("q1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AbortInsert("q2"); err != nil {
+		t.Fatal(err)
+	}
+	files := visible()
+	if len(files) != 2 || !strings.HasSuffix(files[0], ".csv.gz") {
+		t.Fatalf("unexpected files %v", files)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Fatalf("aborted files are left behind: %v", entries)
+	}
+
+	var ids []interface{}
+	for _, file := range files {
+		handler, err := NewHandler(c.fileLocation(partition.NewFileLocation(file, partition.FileTypeCSV)), md, conf, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rg, err := handler.Read(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, rg.Vals[0]...)
+	}
+	if len(ids) != 3 || ids[0] != int64(1) || ids[2] != int64(3) {
+		t.Fatalf("unexpected ids %v", ids)
+	}
+
+	if _, err := c.Insert(rows(5), nil); err != nil || len(visible()) != 3 {
+		t.Fatalf("unexpected insert result %v, %v", err, visible())
+	}
+
+	// the second file can't be renamed onto a directory, it is removed rather than left staged
+	for task := 0; task < 2; task++ {
+		if _, err := c.StageInsert(rows(6), nil, "q3", task); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "part-q3-00001.csv.gz", "taken"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CommitInsert("q3"); err == nil {
+		t.Fatal("expected the commit to fail")
+	}
+	if _, staged, err := c.stagedFiles("q3"); err != nil || len(staged) != 0 {
+		t.Fatalf("staged files are left behind: %v, %v", staged, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "part-q3-00000.csv.gz")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolvedFileConnector(t *testing.T) {
+	dir := t.TempDir()
+	location := filepath.Join(dir, "a.csv")
+	if err := os.WriteFile(location, []byte("id,name\n1,x\n2,y\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	conf := &config.FileConnector{Catalog: "file", Schema: "info", Table: "student", FileType: "csv", Paths: []string{dir}, Header: true, InferSchema: true}
+	c, err := NewFileConnectorFromConfig(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolved, err := c.Resolved()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a scan task reads by the columns inferred when planning, not by the files it would find now
+	if err = os.Remove(location); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "b.csv"), []byte("id,name,extra\n3,z,true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	scan, err := NewResolvedFileConnector(resolved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scan.Partition != nil || scan.DataMetadata.GetColumnNumber() != 2 || scan.DataMetadata.Columns[0].ColumnType != datatype.INT64 {
+		t.Fatalf("unexpected connector %v", scan.DataMetadata)
+	}
+	reader, err := scan.GetReader(partition.NewFileLocation(filepath.Join(dir, "b.csv"), partition.FileTypeCSV), scan.DataMetadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rg, err := reader(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rg.RowsNumber != 1 || rg.Vals[0][0] != int64(3) || rg.Vals[1][0] != "z" {
+		t.Fatalf("unexpected rows %v", rg.Vals)
+	}
+}
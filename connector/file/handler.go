@@ -2,10 +2,12 @@ package file
 
 import (
 	"fmt"
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/pkg/orc"
 	"github.com/gotodb/gotodb/row"
-	"os"
 )
 
 type Handler interface {
@@ -13,20 +15,36 @@ type Handler interface {
 	Write(rb *row.RowsBuffer, indexes []int) (affectedRows int64, err error)
 }
 
-func NewHandler(file *partition.FileLocation, md *metadata.Metadata, readonly bool) (Handler, error) {
+// NewHandler opens a file, conf may be nil and the filters are only hints a reader may use to skip data.
+func NewHandler(file *partition.FileLocation, md *metadata.Metadata, conf *config.FileConnector, readonly bool, filters []*filter.Predicate) (Handler, error) {
 	switch file.FileType {
-	case partition.FileTypeCSV:
-		var osFile *os.File
-		var err error
-		if readonly {
-			osFile, err = os.Open(file.Location)
-		} else {
-			osFile, err = os.OpenFile(file.Location, os.O_RDWR|os.O_APPEND, 644)
+	case partition.FileTypeCSV, partition.FileTypeJSON:
+		f, err := OpenFile(file, readonly)
+		if err != nil {
+			return nil, err
+		}
+		if file.FileType == partition.FileTypeJSON {
+			return NewJSON(f, md), nil
+		}
+		return NewCSV(f, md, conf), nil
+	case partition.FileTypeParquet:
+		if !readonly {
+			return nil, fmt.Errorf("parquet: write is not supported")
+		}
+		parquetFile, err := NewParquetFile(file.Location)
+		if err != nil {
+			return nil, err
+		}
+		return NewParquet(parquetFile, md)
+	case partition.FileTypeORC:
+		if !readonly {
+			return nil, fmt.Errorf("orc: write is not supported")
 		}
+		reader, err := orc.Open(file.Location)
 		if err != nil {
 			return nil, err
 		}
-		return NewCSV(osFile, md), nil
+		return NewORC(reader, md, filters), nil
 	}
 	return nil, fmt.Errorf("file type %d is not defined", file.FileType)
 }
@@ -0,0 +1,202 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+)
+
+const DefaultSampleRows = 1000
+
+// InferSchema samples the first rows of the files of a csv or json table and sets the column
+// names and the narrowest of BOOL, INT64, FLOAT64, DATE, TIMESTAMP and STRING that fits each column.
+// Csv columns are named by the header row, or _c0, _c1, ... without one. The coordinator infers
+// the columns of a scanned table when planning, its scan tasks read by the ones it inferred.
+func InferSchema(conf *config.FileConnector, files []*partition.FileLocation) error {
+	rows := conf.SampleRows
+	if rows == 0 {
+		rows = DefaultSampleRows
+	}
+
+	var (
+		names []string
+		types []datatype.Type
+	)
+	for _, file := range files {
+		if rows <= 0 {
+			break
+		}
+		f, err := OpenFile(file, true)
+		if err != nil {
+			return err
+		}
+		switch file.FileType {
+		case partition.FileTypeCSV:
+			names, types, rows, err = sampleCSV(NewCSV(f, metadata.NewMetadata(), conf), names, types, rows)
+		case partition.FileTypeJSON:
+			names, types, rows, err = sampleJSON(NewJSON(f, metadata.NewMetadata()), names, types, rows)
+		default:
+			err = fmt.Errorf("file connector: schema inference is only supported for csv and json files")
+		}
+		_ = f.Close()
+		if err != nil {
+			return fmt.Errorf("file connector: infer schema from %s: %v", file.Location, err)
+		}
+	}
+
+	conf.ColumnNames, conf.ColumnTypes = names, make([]string, len(types))
+	for i, t := range types {
+		if t == datatype.UnknownType {
+			t = datatype.STRING
+		}
+		conf.ColumnTypes[i] = t.String()
+	}
+	return nil
+}
+
+func sampleCSV(c *CSV, names []string, types []datatype.Type, rows int) ([]string, []datatype.Type, int, error) {
+	if c.Header || c.SkipHeader {
+		record, err := c.Reader.Read()
+		if err == io.EOF {
+			return names, types, rows, nil
+		} else if err != nil {
+			return nil, nil, 0, err
+		}
+		if names == nil {
+			for _, name := range record {
+				names = append(names, strings.TrimSpace(c.swapString(name)))
+			}
+			types = make([]datatype.Type, len(names))
+		}
+	}
+
+	for ; rows > 0; rows-- {
+		record, err := c.Reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, 0, err
+		}
+		for i := len(names); i < len(record); i++ {
+			names, types = append(names, fmt.Sprintf("_c%d", i)), append(types, datatype.UnknownType)
+		}
+		for i, field := range record {
+			value := c.swapString(field)
+			if value == "" || c.NullString != nil && value == *c.NullString {
+				continue
+			}
+			types[i] = widenType(types[i], stringType(value))
+		}
+	}
+	return names, types, rows, nil
+}
+
+func sampleJSON(j *JSON, names []string, types []datatype.Type, rows int) ([]string, []datatype.Type, int, error) {
+	indexes := map[string]int{}
+	for i, name := range names {
+		indexes[name] = i
+	}
+
+	for ; rows > 0; rows-- {
+		var object map[string]interface{}
+		err := j.Decoder.Decode(&object)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, 0, err
+		}
+
+		values := map[string]interface{}{}
+		flattenJSON("", object, values)
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			i, ok := indexes[key]
+			if !ok {
+				i = len(names)
+				indexes[key] = i
+				names, types = append(names, key), append(types, datatype.UnknownType)
+			}
+
+			switch v := values[key].(type) {
+			case nil:
+			case bool:
+				types[i] = widenType(types[i], datatype.BOOL)
+			case json.Number:
+				if _, err = v.Int64(); err == nil {
+					types[i] = widenType(types[i], datatype.INT64)
+				} else {
+					types[i] = widenType(types[i], datatype.FLOAT64)
+				}
+			case string:
+				t := stringType(v)
+				if t != datatype.DATE && t != datatype.TIMESTAMP {
+					t = datatype.STRING
+				}
+				types[i] = widenType(types[i], t)
+			default:
+				types[i] = datatype.STRING
+			}
+		}
+	}
+	return names, types, rows, nil
+}
+
+// flattenJSON collects the leaves of nested objects under their dotted paths.
+func flattenJSON(prefix string, object map[string]interface{}, values map[string]interface{}) {
+	for key, value := range object {
+		if child, ok := value.(map[string]interface{}); ok && len(child) > 0 {
+			flattenJSON(prefix+key+".", child, values)
+		} else {
+			values[prefix+key] = value
+		}
+	}
+}
+
+func stringType(s string) datatype.Type {
+	if strings.EqualFold(s, "true") || strings.EqualFold(s, "false") {
+		return datatype.BOOL
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return datatype.INT64
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return datatype.FLOAT64
+	}
+	if _, err := time.Parse("2006-01-02", s); err == nil {
+		return datatype.DATE
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", time.RFC3339, time.RFC3339Nano} {
+		if _, err := time.Parse(layout, s); err == nil {
+			return datatype.TIMESTAMP
+		}
+	}
+	return datatype.STRING
+}
+
+// widenType returns the narrowest type holding the values of both types.
+func widenType(a, b datatype.Type) datatype.Type {
+	switch {
+	case a == datatype.UnknownType || a == b:
+		return b
+	case b == datatype.UnknownType:
+		return a
+	case (a == datatype.INT64 || a == datatype.FLOAT64) && (b == datatype.INT64 || b == datatype.FLOAT64):
+		return datatype.FLOAT64
+	case (a == datatype.DATE || a == datatype.TIMESTAMP) && (b == datatype.DATE || b == datatype.TIMESTAMP):
+		return datatype.TIMESTAMP
+	}
+	return datatype.STRING
+}
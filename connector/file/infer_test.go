@@ -0,0 +1,58 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/partition"
+)
+
+func TestInferSchema(t *testing.T) {
+	dir := t.TempDir()
+	csvLocation := filepath.Join(dir, "a.csv")
+	csvData := "id,score,born,seen,ok,name\n1,2,2020-01-02,2020-01-02 10:00:00,true,x\n2,2.5,2020-01-03,2020-01-03,false,\n3,,2020-01-04,,TRUE,7\n"
+	if err := os.WriteFile(csvLocation, []byte(csvData), 0644); err != nil {
+		t.Fatal(err)
+	}
+	jsonLocation := filepath.Join(dir, "a.json")
+	jsonData := `{"id": 1, "info": {"age": 10}, "tags": ["x"], "at": "2020-01-02T10:00:00Z"}
+{"id": 2.5, "info": {"age": null}, "extra": true}
+{"id": 3, "info": {"age": 11}}
+`
+	if err := os.WriteFile(jsonLocation, []byte(jsonData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	conf := &config.FileConnector{Header: true}
+	if err := InferSchema(conf, []*partition.FileLocation{partition.NewFileLocation(csvLocation, partition.FileTypeCSV)}); err != nil {
+		t.Fatal(err)
+	}
+	if names := []string{"id", "score", "born", "seen", "ok", "name"}; !reflect.DeepEqual(conf.ColumnNames, names) {
+		t.Fatalf("unexpected names %v", conf.ColumnNames)
+	}
+	if types := []string{"INT64", "FLOAT64", "DATE", "TIMESTAMP", "BOOL", "STRING"}; !reflect.DeepEqual(conf.ColumnTypes, types) {
+		t.Fatalf("unexpected types %v", conf.ColumnTypes)
+	}
+
+	conf = &config.FileConnector{SampleRows: 2}
+	if err := InferSchema(conf, []*partition.FileLocation{partition.NewFileLocation(csvLocation, partition.FileTypeCSV)}); err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.ColumnNames) != 6 || conf.ColumnNames[0] != "_c0" || conf.ColumnTypes[0] != "STRING" || conf.ColumnTypes[1] != "STRING" {
+		t.Fatalf("unexpected schema %v %v", conf.ColumnNames, conf.ColumnTypes)
+	}
+
+	conf = &config.FileConnector{}
+	if err := InferSchema(conf, []*partition.FileLocation{partition.NewFileLocation(jsonLocation, partition.FileTypeJSON)}); err != nil {
+		t.Fatal(err)
+	}
+	if names := []string{"at", "id", "info.age", "tags", "extra"}; !reflect.DeepEqual(conf.ColumnNames, names) {
+		t.Fatalf("unexpected names %v", conf.ColumnNames)
+	}
+	if types := []string{"TIMESTAMP", "FLOAT64", "INT64", "STRING", "BOOL"}; !reflect.DeepEqual(conf.ColumnTypes, types) {
+		t.Fatalf("unexpected types %v", conf.ColumnTypes)
+	}
+}
@@ -0,0 +1,185 @@
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/row"
+)
+
+// JSON reads and writes newline delimited json, one object per row.
+type JSON struct {
+	Closer      io.Closer
+	Metadata    *metadata.Metadata
+	Decoder     *json.Decoder
+	Writer      *bufio.Writer
+	Indexes     []int
+	OutMetadata *metadata.Metadata
+}
+
+func NewJSON(file io.ReadWriteCloser, md *metadata.Metadata) *JSON {
+	decoder := json.NewDecoder(file)
+	decoder.UseNumber()
+	return &JSON{
+		Metadata: md,
+		Decoder:  decoder,
+		Writer:   bufio.NewWriter(file),
+		Closer:   file,
+	}
+}
+
+func (j *JSON) SetColumns(indexes []int) error {
+	cn := j.Metadata.GetColumnNumber()
+	if j.Indexes == nil || len(j.Indexes) == 0 {
+		j.Indexes = make([]int, 0)
+		if indexes == nil {
+			for i := 0; i < cn; i++ {
+				j.Indexes = append(j.Indexes, i)
+			}
+		} else {
+			j.Indexes = indexes
+		}
+
+		for _, i := range j.Indexes {
+			if i >= cn {
+				return fmt.Errorf("json: index out of range")
+			}
+		}
+		j.OutMetadata = j.Metadata.SelectColumnsByIndexes(j.Indexes)
+	}
+	return nil
+}
+
+func (j *JSON) Read(indexes []int) (*row.RowsGroup, error) {
+	var err error
+	if err = j.SetColumns(indexes); err != nil {
+		return nil, err
+	}
+
+	rg := row.NewRowsGroup(j.OutMetadata)
+	for r := 0; r < ReadRowsNumber; r++ {
+		var object map[string]interface{}
+		if err = j.Decoder.Decode(&object); err != nil {
+			break
+		}
+		for i, index := range j.Indexes {
+			value := JSONValue(object, j.Metadata.Columns[index].ColumnName)
+			if value == nil {
+				rg.Vals[i] = append(rg.Vals[i], nil)
+			} else {
+				rg.Vals[i] = append(rg.Vals[i], datatype.ToValue(value, j.Metadata.Columns[index].ColumnType))
+			}
+		}
+		rg.RowsNumber++
+	}
+	if err == io.EOF && rg.RowsNumber > 0 {
+		err = nil
+	}
+
+	if err != nil {
+		_ = j.Closer.Close()
+		return nil, err
+	}
+
+	return rg, nil
+}
+
+func (j *JSON) Write(rb *row.RowsBuffer, indexes []int) (affectedRows int64, err error) {
+	if err = j.SetColumns(indexes); err != nil {
+		return
+	}
+
+	if rb.MD.GetColumnNumber() < len(j.Indexes) {
+		return 0, fmt.Errorf("column does not match")
+	}
+
+	encoder := json.NewEncoder(j.Writer)
+	encoder.SetEscapeHTML(false)
+	var rg *row.RowsGroup
+	for {
+		rg, err = rb.Read()
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			break
+		}
+		for r := 0; r < rg.RowsNumber; r++ {
+			object := map[string]interface{}{}
+			for columnNum, index := range j.Indexes {
+				value := rg.Vals[columnNum][r]
+				switch v := value.(type) {
+				case datatype.Date:
+					value = v.String()
+				case datatype.Timestamp:
+					value = v.String()
+				}
+				setJSONValue(object, j.Metadata.Columns[index].ColumnName, value)
+			}
+			if err = encoder.Encode(object); err != nil {
+				return
+			}
+			affectedRows++
+		}
+	}
+
+	if err = j.Writer.Flush(); err != nil {
+		return
+	}
+
+	if err = j.Closer.Close(); err != nil {
+		return
+	}
+	return
+}
+
+// JSONValue looks a column up in a json object, either as a top level key or as a dotted path
+// into nested objects. Numbers are returned as int64 or float64, arrays and objects as json text.
+func JSONValue(object map[string]interface{}, name string) interface{} {
+	value, ok := object[name]
+	if !ok {
+		var cur interface{} = object
+		for _, key := range strings.Split(name, ".") {
+			m, isObject := cur.(map[string]interface{})
+			if !isObject {
+				return nil
+			}
+			if cur, ok = m[key]; !ok {
+				return nil
+			}
+		}
+		value = cur
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return value
+}
+
+// setJSONValue sets a column in a json object, creating nested objects for a dotted name.
+func setJSONValue(object map[string]interface{}, name string, value interface{}) {
+	keys := strings.Split(name, ".")
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			object[key] = child
+		}
+		object = child
+	}
+	object[keys[len(keys)-1]] = value
+}
@@ -0,0 +1,80 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+func TestJSONReadWrite(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "student.json")
+	data := `{"id": 1, "name": "a", "info": {"age": 10, "tags": ["x"]}}
+{"id": 9007199254740993, "name": null}
+
+{"id": 3, "name": "c", "info": {"age": 12.5}}
+`
+	if err := os.WriteFile(location, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "info", "student", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "name"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.FLOAT64, "file", "info", "student", "info.age"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "info.tags"))
+	file := partition.NewFileLocation(location, partition.FileTypeJSON)
+
+	read := func() *row.RowsGroup {
+		handler, err := NewHandler(file, md, nil, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rg, err := handler.Read(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = handler.Read(nil); err != io.EOF {
+			t.Fatalf("expected EOF, got %v", err)
+		}
+		return rg
+	}
+
+	rg := read()
+	if rg.RowsNumber != 3 {
+		t.Fatalf("expected 3 rows, got %d", rg.RowsNumber)
+	}
+	if rg.Vals[0][1] != int64(9007199254740993) || rg.Vals[1][1] != nil || rg.Vals[2][2] != 12.5 || rg.Vals[3][0] != `["x"]` {
+		t.Fatalf("unexpected values %v", rg.Vals)
+	}
+
+	in := row.NewRowsGroup(md.SelectColumnsByIndexes([]int{0, 2}))
+	in.AppendRowVals(int64(4), float64(20))
+	var buf bytes.Buffer
+	rb := row.NewRowsBuffer(in.Metadata, &buf, &buf)
+	if err := rb.Write(in); err != nil {
+		t.Fatal(err)
+	}
+	if err := rb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := NewHandler(file, md, nil, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := handler.Write(rb, []int{0, 2}); err != nil || n != 1 {
+		t.Fatalf("unexpected write result %d, %v", n, err)
+	}
+
+	rg = read()
+	if rg.RowsNumber != 4 || rg.Vals[0][3] != int64(4) || rg.Vals[2][3] != float64(20) || rg.Vals[1][3] != nil {
+		t.Fatalf("unexpected values %v", rg.Vals)
+	}
+}
@@ -0,0 +1,234 @@
+package file
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/pkg/orc"
+	"github.com/gotodb/gotodb/row"
+)
+
+type ORC struct {
+	Metadata    *metadata.Metadata
+	Reader      *orc.Reader
+	Indexes     []int
+	OutMetadata *metadata.Metadata
+	Predicates  []*Predicate
+	// Columns maps a metadata column index to its orc top level field, a column the file
+	// doesn't have reads as NULL
+	Columns map[int]*orc.Field
+	Stripe  int
+	Current *orc.Stripe
+	Remain  uint64
+}
+
+func NewORC(reader *orc.Reader, md *metadata.Metadata, filters []*filter.Predicate) *ORC {
+	res := &ORC{
+		Metadata:   md,
+		Reader:     reader,
+		Predicates: ComparePredicates(filters),
+		Columns:    map[int]*orc.Field{},
+	}
+
+	names := map[string]*orc.Field{}
+	for _, field := range reader.Fields() {
+		names[strings.ToLower(field.Name)] = field
+	}
+	for i, column := range md.Columns {
+		if field, ok := names[strings.ToLower(column.ColumnName)]; ok {
+			res.Columns[i] = field
+		}
+	}
+	return res
+}
+
+func (o *ORC) SetColumns(indexes []int) error {
+	cn := o.Metadata.GetColumnNumber()
+	if o.Indexes == nil || len(o.Indexes) == 0 {
+		o.Indexes = make([]int, 0)
+		if indexes == nil {
+			for i := 0; i < cn; i++ {
+				o.Indexes = append(o.Indexes, i)
+			}
+		} else {
+			o.Indexes = indexes
+		}
+
+		for _, i := range o.Indexes {
+			if i >= cn {
+				return fmt.Errorf("orc: index out of range")
+			}
+		}
+		o.OutMetadata = o.Metadata.SelectColumnsByIndexes(o.Indexes)
+	}
+	return nil
+}
+
+// skipStripe reports whether the statistics of a stripe rule out every row for the predicates.
+func (o *ORC) skipStripe(stripe int) bool {
+	for _, p := range o.Predicates {
+		index, err := o.Metadata.GetIndexByName(p.Column)
+		if err != nil {
+			continue
+		}
+		field, ok := o.Columns[index]
+		if !ok || field.Type == nil {
+			continue
+		}
+		t := o.Metadata.Columns[index].ColumnType
+		if ORCType(field.Type) != t {
+			continue
+		}
+		stats := o.Reader.Statistics(stripe, field.Column)
+		if stats == nil {
+			continue
+		}
+		if stats.NumberOfValues == 0 && stats.HasNull {
+			// comparisons with null are never true
+			return true
+		}
+		min, max := ORCStatistic(stats.Min, field.Type), ORCStatistic(stats.Max, field.Type)
+		if min != nil && max != nil && !p.MayMatch(datatype.ToValue(min, t), datatype.ToValue(max, t), t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *ORC) nextStripe() error {
+	columns := make([]uint32, 0, len(o.Indexes))
+	for _, index := range o.Indexes {
+		if field, ok := o.Columns[index]; ok {
+			columns = append(columns, field.Column)
+		}
+	}
+
+	for o.Stripe < o.Reader.NumStripes() {
+		i := o.Stripe
+		o.Stripe++
+		if o.skipStripe(i) {
+			continue
+		}
+		stripe, err := o.Reader.ReadStripe(i, columns)
+		if err != nil {
+			return err
+		}
+		o.Current, o.Remain = stripe, stripe.NumRows
+		if o.Remain > 0 {
+			return nil
+		}
+	}
+	return io.EOF
+}
+
+func (o *ORC) Read(indexes []int) (*row.RowsGroup, error) {
+	if err := o.SetColumns(indexes); err != nil {
+		return nil, err
+	}
+
+	if o.Current == nil || o.Remain == 0 {
+		if err := o.nextStripe(); err != nil {
+			_ = o.Reader.Close()
+			return nil, err
+		}
+	}
+
+	num := o.Remain
+	if num > ReadRowsNumber {
+		num = ReadRowsNumber
+	}
+
+	rg := row.NewRowsGroup(o.OutMetadata)
+	for i, index := range o.Indexes {
+		field, ok := o.Columns[index]
+		if !ok {
+			rg.Vals[i] = make([]interface{}, num)
+			continue
+		}
+		values, err := o.Current.Columns[field.Column].Next(int(num))
+		if err != nil {
+			_ = o.Reader.Close()
+			return nil, err
+		}
+
+		t := o.Metadata.Columns[index].ColumnType
+		for _, value := range values {
+			if value == nil {
+				rg.Vals[i] = append(rg.Vals[i], nil)
+			} else {
+				rg.Vals[i] = append(rg.Vals[i], datatype.ToValue(ORCValue(value, field.Type), t))
+			}
+		}
+	}
+	rg.RowsNumber = int(num)
+	o.Remain -= num
+
+	return rg, nil
+}
+
+func (o *ORC) Write(_ *row.RowsBuffer, _ []int) (affectedRows int64, err error) {
+	return 0, fmt.Errorf("orc: write is not supported")
+}
+
+// ORCType maps an orc column type onto datatype.Type.
+func ORCType(t *orc.Type) datatype.Type {
+	switch t.Kind {
+	case orc.TypeBoolean:
+		return datatype.BOOL
+	case orc.TypeByte:
+		return datatype.INT8
+	case orc.TypeShort:
+		return datatype.INT16
+	case orc.TypeInt:
+		return datatype.INT32
+	case orc.TypeLong:
+		return datatype.INT64
+	case orc.TypeFloat:
+		return datatype.FLOAT32
+	case orc.TypeDouble, orc.TypeDecimal:
+		return datatype.FLOAT64
+	case orc.TypeString, orc.TypeVarchar, orc.TypeChar, orc.TypeBinary:
+		return datatype.STRING
+	case orc.TypeTimestamp, orc.TypeTimestampInstant:
+		return datatype.TIMESTAMP
+	case orc.TypeDate:
+		return datatype.DATE
+	}
+	return datatype.UnknownType
+}
+
+// ORCValue converts a decoded orc value into the go value of its ORCType.
+func ORCValue(v interface{}, t *orc.Type) interface{} {
+	switch t.Kind {
+	case orc.TypeDate:
+		return datatype.Date{Sec: v.(time.Time).Unix()}
+	case orc.TypeTimestamp, orc.TypeTimestampInstant:
+		return datatype.Timestamp{Sec: v.(time.Time).Unix()}
+	}
+	return v
+}
+
+// ORCStatistic converts a stripe statistics bound into the go value of the ORCType of the column.
+func ORCStatistic(v interface{}, t *orc.Type) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch t.Kind {
+	case orc.TypeDate:
+		return datatype.Date{Sec: v.(int64) * 24 * 3600}
+	case orc.TypeTimestamp, orc.TypeTimestampInstant:
+		ms := v.(int64)
+		if ms < 0 {
+			ms -= 999
+		}
+		return datatype.Timestamp{Sec: ms / 1000}
+	case orc.TypeDecimal:
+		return datatype.ToFloat64(v)
+	}
+	return v
+}
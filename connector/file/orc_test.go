@@ -0,0 +1,47 @@
+package file
+
+import (
+	"io"
+	"testing"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+)
+
+func TestORCMissingColumn(t *testing.T) {
+	// email isn't in the file and comes first, so no column may be read in its place
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "stats", "email"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "stats", "string1"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT32, "file", "info", "stats", "int1"))
+
+	location := partition.NewFileLocation("../../pkg/orc/testdata/TestOrcFile.testStripeLevelStats.orc", partition.FileTypeORC)
+	handler, err := NewHandler(location, md, nil, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[int32]string{1: "one", 2: "two", 3: "three"}
+	rows := 0
+	for {
+		rg, err := handler.Read(nil)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < rg.RowsNumber; i++ {
+			if rg.Vals[0][i] != nil {
+				t.Fatalf("expected NULL email, got %v", rg.Vals[0][i])
+			}
+			if int1 := rg.Vals[2][i].(int32); rg.Vals[1][i] != names[int1] {
+				t.Fatalf("unexpected row %v %v", rg.Vals[1][i], int1)
+			}
+		}
+		rows += rg.RowsNumber
+	}
+	if rows != 11000 {
+		t.Fatalf("expected 11000 rows, got %d", rows)
+	}
+}
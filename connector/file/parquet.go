@@ -0,0 +1,295 @@
+package file
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/row"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/types"
+)
+
+// ParquetFile implements source.ParquetFile on top of the local file system.
+type ParquetFile struct {
+	Path string
+	*os.File
+}
+
+func NewParquetFile(path string) (*ParquetFile, error) {
+	osFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetFile{Path: path, File: osFile}, nil
+}
+
+func (f *ParquetFile) Open(name string) (source.ParquetFile, error) {
+	if name == "" {
+		name = f.Path
+	}
+	return NewParquetFile(name)
+}
+
+func (f *ParquetFile) Create(name string) (source.ParquetFile, error) {
+	osFile, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	return &ParquetFile{Path: name, File: osFile}, nil
+}
+
+type Parquet struct {
+	Metadata    *metadata.Metadata
+	File        *ParquetFile
+	Reader      *reader.ParquetReader
+	Indexes     []int
+	OutMetadata *metadata.Metadata
+	// Columns maps a metadata column index to its parquet leaf column path
+	Columns  map[int]string
+	Elements map[int]*parquet.SchemaElement
+	NumRows  int64
+	ReadRows int64
+}
+
+func NewParquet(file *ParquetFile, md *metadata.Metadata) (*Parquet, error) {
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	res := &Parquet{
+		Metadata: md,
+		File:     file,
+		Reader:   pr,
+		Columns:  map[int]string{},
+		Elements: map[int]*parquet.SchemaElement{},
+		NumRows:  pr.GetNumRows(),
+	}
+
+	sh := pr.SchemaHandler
+	names := map[string]string{}
+	for _, path := range sh.ValueColumns {
+		exPath := common.StrToPath(sh.InPathToExPath[path])
+		names[strings.ToLower(strings.Join(exPath[1:], "."))] = path
+	}
+
+	for i, column := range md.Columns {
+		// a column the file doesn't have reads as NULL
+		path, ok := names[strings.ToLower(column.ColumnName)]
+		if !ok {
+			continue
+		}
+		el := sh.SchemaElements[sh.MapIndex[path]]
+		if maxRL, _ := sh.MaxRepetitionLevel(common.StrToPath(path)); maxRL > 0 {
+			return nil, fmt.Errorf("parquet: repeated column %s is not supported", column.ColumnName)
+		}
+		res.Columns[i] = path
+		res.Elements[i] = el
+	}
+
+	return res, nil
+}
+
+func (p *Parquet) SetColumns(indexes []int) error {
+	cn := p.Metadata.GetColumnNumber()
+	if p.Indexes == nil || len(p.Indexes) == 0 {
+		p.Indexes = make([]int, 0)
+		if indexes == nil {
+			for i := 0; i < cn; i++ {
+				p.Indexes = append(p.Indexes, i)
+			}
+		} else {
+			p.Indexes = indexes
+		}
+
+		for _, i := range p.Indexes {
+			if i >= cn {
+				return fmt.Errorf("parquet: index out of range")
+			}
+		}
+		p.OutMetadata = p.Metadata.SelectColumnsByIndexes(p.Indexes)
+	}
+	return nil
+}
+
+func (p *Parquet) Read(indexes []int) (*row.RowsGroup, error) {
+	if err := p.SetColumns(indexes); err != nil {
+		return nil, err
+	}
+
+	if p.ReadRows >= p.NumRows {
+		p.Reader.ReadStop()
+		_ = p.File.Close()
+		return nil, io.EOF
+	}
+
+	num := p.NumRows - p.ReadRows
+	if num > ReadRowsNumber {
+		num = ReadRowsNumber
+	}
+
+	rg := row.NewRowsGroup(p.OutMetadata)
+	for i, index := range p.Indexes {
+		path, ok := p.Columns[index]
+		if !ok {
+			rg.Vals[i] = make([]interface{}, num)
+			continue
+		}
+		values, _, _, err := p.Reader.ReadColumnByPath(path, num)
+		if err != nil {
+			_ = p.File.Close()
+			return nil, err
+		}
+		if int64(len(values)) != num {
+			_ = p.File.Close()
+			return nil, fmt.Errorf("parquet: column %s has %d values, expected %d", p.Metadata.Columns[index].ColumnName, len(values), num)
+		}
+
+		el := p.Elements[index]
+		t := p.Metadata.Columns[index].ColumnType
+		for _, value := range values {
+			if value == nil {
+				rg.Vals[i] = append(rg.Vals[i], nil)
+			} else {
+				rg.Vals[i] = append(rg.Vals[i], datatype.ToValue(ParquetValue(value, el), t))
+			}
+		}
+	}
+	rg.RowsNumber = int(num)
+	p.ReadRows += num
+
+	return rg, nil
+}
+
+func (p *Parquet) Write(_ *row.RowsBuffer, _ []int) (affectedRows int64, err error) {
+	return 0, fmt.Errorf("parquet: write is not supported")
+}
+
+// ParquetType maps the physical and logical type of a parquet leaf column onto datatype.Type.
+func ParquetType(el *parquet.SchemaElement) datatype.Type {
+	lt := el.GetLogicalType()
+	switch {
+	case lt != nil && lt.IsSetDATE():
+		return datatype.DATE
+	case lt != nil && lt.IsSetTIMESTAMP():
+		return datatype.TIMESTAMP
+	case lt != nil && lt.IsSetDECIMAL():
+		return datatype.FLOAT64
+	case lt != nil && lt.IsSetINTEGER():
+		it := lt.GetINTEGER()
+		switch {
+		case it.GetBitWidth() == 8 && it.GetIsSigned():
+			return datatype.INT8
+		case it.GetBitWidth() == 8:
+			return datatype.UINT8
+		case it.GetBitWidth() == 16 && it.GetIsSigned():
+			return datatype.INT16
+		case it.GetBitWidth() == 16:
+			return datatype.UINT16
+		case it.GetBitWidth() == 32 && it.GetIsSigned():
+			return datatype.INT32
+		case it.GetBitWidth() == 32:
+			return datatype.UINT32
+		case it.GetIsSigned():
+			return datatype.INT64
+		default:
+			return datatype.UINT64
+		}
+	}
+
+	if el.IsSetConvertedType() {
+		switch el.GetConvertedType() {
+		case parquet.ConvertedType_DATE:
+			return datatype.DATE
+		case parquet.ConvertedType_TIMESTAMP_MILLIS, parquet.ConvertedType_TIMESTAMP_MICROS:
+			return datatype.TIMESTAMP
+		case parquet.ConvertedType_DECIMAL:
+			return datatype.FLOAT64
+		case parquet.ConvertedType_INT_8:
+			return datatype.INT8
+		case parquet.ConvertedType_INT_16:
+			return datatype.INT16
+		case parquet.ConvertedType_INT_32:
+			return datatype.INT32
+		case parquet.ConvertedType_INT_64:
+			return datatype.INT64
+		case parquet.ConvertedType_UINT_8:
+			return datatype.UINT8
+		case parquet.ConvertedType_UINT_16:
+			return datatype.UINT16
+		case parquet.ConvertedType_UINT_32:
+			return datatype.UINT32
+		case parquet.ConvertedType_UINT_64:
+			return datatype.UINT64
+		}
+	}
+
+	switch el.GetType() {
+	case parquet.Type_BOOLEAN:
+		return datatype.BOOL
+	case parquet.Type_INT32:
+		return datatype.INT32
+	case parquet.Type_INT64:
+		return datatype.INT64
+	case parquet.Type_INT96:
+		return datatype.TIMESTAMP
+	case parquet.Type_FLOAT:
+		return datatype.FLOAT32
+	case parquet.Type_DOUBLE:
+		return datatype.FLOAT64
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		return datatype.STRING
+	}
+	return datatype.UnknownType
+}
+
+// ParquetValue converts a raw parquet value into the go value of its ParquetType.
+func ParquetValue(v interface{}, el *parquet.SchemaElement) interface{} {
+	t := ParquetType(el)
+	switch t {
+	case datatype.DATE:
+		return datatype.Date{Sec: int64(datatype.ToInt32(v)) * 24 * 3600}
+	case datatype.TIMESTAMP:
+		if el.GetType() == parquet.Type_INT96 {
+			return datatype.Timestamp{Sec: types.INT96ToTime(v.(string)).Unix()}
+		}
+		ts := datatype.ToInt64(v)
+		lt := el.GetLogicalType()
+		switch {
+		case lt != nil && lt.IsSetTIMESTAMP() && lt.GetTIMESTAMP().GetUnit().IsSetNANOS():
+			return datatype.Timestamp{Sec: ts / 1e9}
+		case lt != nil && lt.IsSetTIMESTAMP() && lt.GetTIMESTAMP().GetUnit().IsSetMICROS(),
+			el.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS:
+			return datatype.Timestamp{Sec: ts / 1e6}
+		default:
+			return datatype.Timestamp{Sec: ts / 1e3}
+		}
+	case datatype.FLOAT64:
+		if el.GetType() == parquet.Type_DOUBLE {
+			return v
+		}
+		var s string
+		switch dec := v.(type) {
+		case int32:
+			s = types.DECIMAL_INT_ToString(int64(dec), int(el.GetPrecision()), int(el.GetScale()))
+		case int64:
+			s = types.DECIMAL_INT_ToString(dec, int(el.GetPrecision()), int(el.GetScale()))
+		case string:
+			s = types.DECIMAL_BYTE_ARRAY_ToString([]byte(dec), int(el.GetPrecision()), int(el.GetScale()))
+		}
+		f, _ := strconv.ParseFloat(s, 64)
+		return f
+	case datatype.UnknownType:
+		return v
+	}
+	return datatype.ToValue(v, t)
+}
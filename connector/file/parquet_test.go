@@ -0,0 +1,145 @@
+package file
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type student struct {
+	ID       int64   `parquet:"name=id, type=INT64"`
+	Name     string  `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Age      *int32  `parquet:"name=age, type=INT32, repetitiontype=OPTIONAL"`
+	Birthday int32   `parquet:"name=birthday, type=INT32, convertedtype=DATE"`
+	Score    float64 `parquet:"name=score, type=DOUBLE"`
+}
+
+func TestParquetRead(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "student.parquet")
+	pf := &ParquetFile{}
+	fw, err := pf.Create(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw, err := writer.NewParquetWriter(fw, new(student), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw.RowGroupSize = 1024
+	total := ReadRowsNumber + 10
+	for i := 0; i < total; i++ {
+		s := student{ID: int64(i), Name: "name", Birthday: 1, Score: float64(i) / 2}
+		if i%2 == 0 {
+			age := int32(i % 100)
+			s.Age = &age
+		}
+		if err = pw.Write(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = pw.WriteStop(); err != nil {
+		t.Fatal(err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "name"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "info", "student", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT32, "file", "info", "student", "age"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.DATE, "file", "info", "student", "birthday"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.FLOAT64, "file", "info", "student", "score"))
+
+	handler, err := NewHandler(partition.NewFileLocation(location, partition.FileTypeParquet), md, nil, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := 0
+	for {
+		rg, err := handler.Read([]int{1, 2, 3})
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rg.Vals) != 3 {
+			t.Fatalf("expected 3 columns, got %d", len(rg.Vals))
+		}
+		for i := 0; i < rg.RowsNumber; i++ {
+			if id := rg.Vals[0][i].(int64); id != int64(rows) {
+				t.Fatalf("expected id %d, got %d", rows, id)
+			}
+			if age := rg.Vals[1][i]; (rows%2 == 0) != (age != nil) {
+				t.Fatalf("unexpected age %v at row %d", age, rows)
+			}
+			if date := rg.Vals[2][i].(datatype.Date); date.Sec != 24*3600 {
+				t.Fatalf("unexpected date %v", date)
+			}
+			rows++
+		}
+	}
+	if rows != total {
+		t.Fatalf("expected %d rows, got %d", total, rows)
+	}
+}
+
+func TestParquetMissingColumn(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "student.parquet")
+	pf := &ParquetFile{}
+	fw, err := pf.Create(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw, err := writer.NewParquetWriter(fw, new(student), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err = pw.Write(student{ID: int64(i), Name: "name", Score: float64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = pw.WriteStop(); err != nil {
+		t.Fatal(err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// email isn't in the file and comes first, so no column may be read in its place
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "info", "student", "email"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.FLOAT64, "file", "info", "student", "score"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "info", "student", "id"))
+
+	handler, err := NewHandler(partition.NewFileLocation(location, partition.FileTypeParquet), md, nil, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rg, err := handler.Read(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rg.RowsNumber != 3 {
+		t.Fatalf("expected 3 rows, got %d", rg.RowsNumber)
+	}
+	for i := 0; i < rg.RowsNumber; i++ {
+		if rg.Vals[0][i] != nil {
+			t.Errorf("expected NULL email, got %v", rg.Vals[0][i])
+		}
+		if score := rg.Vals[1][i].(float64); score != float64(i) {
+			t.Errorf("expected score %d, got %v", i, score)
+		}
+		if id := rg.Vals[2][i].(int64); id != int64(i) {
+			t.Errorf("expected id %d, got %v", i, id)
+		}
+	}
+}
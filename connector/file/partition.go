@@ -0,0 +1,263 @@
+package file
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+// partitionDir is a partition of a hive style layout and the files in it.
+type partitionDir struct {
+	location string
+	values   []string
+	files    []string
+}
+
+// fileLister collects the files of a table, grouping them by their partition values.
+type fileLister struct {
+	conf    *config.FileConnector
+	keys    []string
+	hasKeys bool
+	dirs    []*partitionDir
+	dirMap  map[string]*partitionDir
+}
+
+// hidden reports whether a file or directory is a hidden or bookkeeping one, like .part-0.crc,
+// _SUCCESS or _temporary, which hadoop, hive and spark all leave out of a table.
+func hidden(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+// matchAny reports whether any pattern matches the relative path or, for patterns without a
+// separator, its base name.
+func matchAny(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = filepath.Base(rel)
+		}
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionValues turns the key=value components of a relative directory into partition columns.
+func partitionValues(rel string) (names, values []string, err error) {
+	if rel == "." || rel == "" {
+		return nil, nil, nil
+	}
+	for _, component := range strings.Split(filepath.ToSlash(rel), "/") {
+		key, value, ok := strings.Cut(component, "=")
+		if !ok || key == "" {
+			continue
+		}
+		if value, err = url.PathUnescape(value); err != nil {
+			return nil, nil, fmt.Errorf("file connector: invalid partition directory %s: %v", component, err)
+		}
+		names, values = append(names, key), append(values, value)
+	}
+	return names, values, nil
+}
+
+func (l *fileLister) addFile(root, path string, names, values []string) error {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return err
+	}
+	if len(l.conf.Include) > 0 && !matchAny(l.conf.Include, rel) || matchAny(l.conf.Exclude, rel) {
+		return nil
+	}
+
+	if !l.hasKeys {
+		l.keys, l.hasKeys = names, true
+	} else if strings.Join(l.keys, "/") != strings.Join(names, "/") {
+		return fmt.Errorf("file connector: %s is not partitioned by (%s)", path, strings.Join(l.keys, ", "))
+	}
+
+	key := strings.Join(values, "/")
+	dir, ok := l.dirMap[key]
+	if !ok {
+		dir = &partitionDir{location: filepath.Dir(path), values: values}
+		l.dirMap[key] = dir
+		l.dirs = append(l.dirs, dir)
+	}
+	dir.files = append(dir.files, path)
+	return nil
+}
+
+// walk lists a directory, descending into key=value directories and, if recursive, into the others.
+func (l *fileLister) walk(root, location string, names, values []string) error {
+	entries, err := os.ReadDir(location)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if hidden(entry.Name()) {
+			continue
+		}
+		path := filepath.Join(location, entry.Name())
+		if !entry.IsDir() {
+			if err = l.addFile(root, path, names, values); err != nil {
+				return err
+			}
+			continue
+		}
+
+		subNames, subValues, err := partitionValues(entry.Name())
+		if err != nil {
+			return err
+		}
+		if len(subNames) == 0 && !l.conf.Recursive {
+			continue
+		}
+		subNames = append(append([]string{}, names...), subNames...)
+		subValues = append(append([]string{}, values...), subValues...)
+		if err = l.walk(root, path, subNames, subValues); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add lists a configured path, which is a directory, a file or a glob pattern. Partition columns
+// of glob matches come from the key=value directories after the static part of the pattern.
+func (l *fileLister) add(location string) error {
+	root := location
+	matches := []string{location}
+	if strings.ContainsAny(location, "*?[") {
+		var err error
+		if matches, err = filepath.Glob(location); err != nil {
+			return fmt.Errorf("file connector: invalid path pattern %s: %v", location, err)
+		}
+		var static []string
+		for _, component := range strings.Split(location, string(filepath.Separator)) {
+			if strings.ContainsAny(component, "*?[") {
+				break
+			}
+			static = append(static, component)
+		}
+		root = strings.Join(static, string(filepath.Separator))
+		if root == "" {
+			root = string(filepath.Separator)
+		}
+	}
+
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil {
+			return err
+		}
+		if match != location && hidden(info.Name()) {
+			continue
+		}
+
+		dir := match
+		if !info.IsDir() {
+			dir = filepath.Dir(match)
+		}
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return err
+		}
+		names, values, err := partitionValues(rel)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			err = l.walk(match, match, names, values)
+		} else {
+			err = l.addFile(dir, match, names, values)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadPartition lists the files under the configured paths. Directories named key=value become
+// partition columns, in the order they nest, typed by the narrowest type that fits all their values
+// unless the config declares the partitions.
+func ReadPartition(conf *config.FileConnector, fileType partition.FileType) (*partition.Partition, error) {
+	l := &fileLister{conf: conf, dirMap: map[string]*partitionDir{}}
+	if len(conf.PartitionNames) > 0 {
+		l.keys, l.hasKeys = conf.PartitionNames, true
+	}
+	for _, location := range conf.Paths {
+		if err := l.add(location); err != nil {
+			return nil, err
+		}
+	}
+	keys, dirs := l.keys, l.dirs
+
+	if len(keys) == 0 {
+		res := partition.New(metadata.NewMetadata())
+		for _, dir := range dirs {
+			for _, file := range dir.files {
+				res.Locations = append(res.Locations, file)
+				res.FileTypes = append(res.FileTypes, fileType)
+			}
+		}
+		return res, nil
+	}
+
+	md := metadata.NewMetadata()
+	for i, key := range keys {
+		values := make([]string, len(dirs))
+		for j, dir := range dirs {
+			values[j] = dir.values[i]
+		}
+		t := partitionType(values)
+		if i < len(conf.PartitionTypes) {
+			t = datatype.FromString(conf.PartitionTypes[i])
+		}
+		md.AppendColumn(metadata.NewColumnMetadata(t, conf.Catalog, conf.Schema, conf.Table, key))
+	}
+
+	res := partition.New(md)
+	for _, dir := range dirs {
+		r := row.NewRow()
+		for i, value := range dir.values {
+			r.AppendVals(datatype.ToValue(value, md.Columns[i].ColumnType))
+		}
+		res.Write(r)
+		res.Locations = append(res.Locations, dir.location)
+		res.FileTypes = append(res.FileTypes, fileType)
+
+		var files []*partition.FileLocation
+		for _, file := range dir.files {
+			files = append(files, partition.NewFileLocation(file, fileType))
+		}
+		res.FileLists = append(res.FileLists, files)
+	}
+	return res, nil
+}
+
+func partitionType(values []string) datatype.Type {
+	t := datatype.INT64
+	for _, value := range values {
+		if t == datatype.INT64 {
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				t = datatype.FLOAT64
+			}
+		}
+		if t == datatype.FLOAT64 {
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return datatype.STRING
+			}
+		}
+	}
+	return t
+}
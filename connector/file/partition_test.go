@@ -0,0 +1,120 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/partition"
+)
+
+func TestReadPartition(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"dt=1/city=a%2Fb/0.csv", "dt=1/city=a%2Fb/1.csv", "dt=2.5/city=c/0.csv"} {
+		location := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(location), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(location, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	conf := &config.FileConnector{Catalog: "file", Schema: "info", Table: "student", Paths: []string{dir}}
+	part, err := ReadPartition(conf, partition.FileTypeCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !part.IsPartition() || part.GetPartitionNum() != 2 {
+		t.Fatalf("expected 2 partitions, got %d", part.GetPartitionNum())
+	}
+	if part.Metadata.Columns[0].ColumnName != "dt" || part.Metadata.Columns[0].ColumnType != datatype.FLOAT64 ||
+		part.Metadata.Columns[1].ColumnName != "city" || part.Metadata.Columns[1].ColumnType != datatype.STRING {
+		t.Fatalf("unexpected partition columns %v", part.Metadata.GetColumnNames())
+	}
+	if r := part.GetPartitionRow(0); r.Vals[0] != float64(1) || r.Vals[1] != "a/b" || len(part.GetPartitionFiles(0)) != 2 {
+		t.Fatalf("unexpected partition %v", r.Vals)
+	}
+
+	declared := *conf
+	declared.PartitionNames, declared.PartitionTypes = []string{"dt", "city"}, []string{"STRING", "STRING"}
+	if part, err = ReadPartition(&declared, partition.FileTypeCSV); err != nil || part.Metadata.Columns[0].ColumnType != datatype.STRING {
+		t.Fatalf("unexpected declared partitions %v, %v", part, err)
+	}
+	declared.PartitionNames, declared.PartitionTypes = []string{"dt"}, []string{"STRING"}
+	if _, err = ReadPartition(&declared, partition.FileTypeCSV); err == nil {
+		t.Fatal("expected an error for files outside the declared partitions")
+	}
+	declared.Paths = []string{t.TempDir()}
+	if part, err = ReadPartition(&declared, partition.FileTypeCSV); err != nil || !part.IsPartition() || part.GetPartitionNum() != 0 {
+		t.Fatalf("unexpected empty partitions %v, %v", part, err)
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, "dt=2.5", "0.csv"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ReadPartition(conf, partition.FileTypeCSV); err == nil {
+		t.Fatal("expected an error for a file outside the partition layout")
+	}
+}
+
+func TestReadPartitionPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"2024-01/part-0.csv", "2024-01/_SUCCESS", "2024-01/.part-0.csv.crc", "2024-01/nested/part-1.csv",
+		"2024-02/part-0.csv", "2024-02/part-0.tmp", "2024-02/_temporary/part-9.csv", "2023-12/part-0.csv",
+		"dt=1/part-0.csv", "dt=2/part-0.csv", "dt=2/skip.csv", "single.csv",
+	} {
+		location := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(location), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(location, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, c := range []struct {
+		conf  *config.FileConnector
+		files []string
+		parts int
+	}{
+		{
+			conf:  &config.FileConnector{Paths: []string{filepath.Join(dir, "2024-*", "part-*.csv"), filepath.Join(dir, "single.csv")}},
+			files: []string{"2024-01/part-0.csv", "2024-02/part-0.csv", "single.csv"},
+		},
+		{
+			conf:  &config.FileConnector{Paths: []string{filepath.Join(dir, "2024-*")}, Recursive: true, Exclude: []string{"*.tmp"}},
+			files: []string{"2024-01/nested/part-1.csv", "2024-01/part-0.csv", "2024-02/part-0.csv"},
+		},
+		{
+			conf:  &config.FileConnector{Paths: []string{filepath.Join(dir, "dt=*")}, Include: []string{"part-*"}},
+			files: []string{"dt=1/part-0.csv", "dt=2/part-0.csv"},
+			parts: 2,
+		},
+	} {
+		part, err := ReadPartition(c.conf, partition.FileTypeCSV)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files := part.Locations
+		if part.IsPartition() {
+			files = nil
+			for i := 0; i < part.GetPartitionNum(); i++ {
+				for _, file := range part.GetPartitionFiles(i) {
+					files = append(files, file.Location)
+				}
+			}
+		}
+		if part.GetPartitionNum() != c.parts || len(files) != len(c.files) {
+			t.Fatalf("%v: unexpected files %v", c.conf.Paths, files)
+		}
+		for i, file := range files {
+			if file != filepath.Join(dir, c.files[i]) {
+				t.Fatalf("%v: unexpected files %v", c.conf.Paths, files)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package file
+
+import (
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+)
+
+// Predicate is a pushed down filter of the form "column operator literal".
+type Predicate struct {
+	Column   string
+	Operator datatype.Operator
+	Value    interface{}
+}
+
+// ComparePredicates extracts the comparisons that all rows must pass from the filters, a between
+// becomes two of them and other filters are ignored.
+func ComparePredicates(filters []*filter.Predicate) []*Predicate {
+	var res []*Predicate
+	for _, p := range filters {
+		switch p.Kind {
+		case filter.And:
+			res = append(res, ComparePredicates(p.Children)...)
+		case filter.Compare:
+			res = append(res, &Predicate{Column: p.Column, Operator: p.Operator, Value: p.Values[0]})
+		case filter.Between:
+			res = append(res,
+				&Predicate{Column: p.Column, Operator: datatype.GTE, Value: p.Values[0]},
+				&Predicate{Column: p.Column, Operator: datatype.LTE, Value: p.Values[1]})
+		}
+	}
+	return res
+}
+
+// MayMatch reports whether a column of type t whose values lie within [min, max] may satisfy the predicate.
+func (p *Predicate) MayMatch(min, max interface{}, t datatype.Type) bool {
+	if min == nil || max == nil {
+		return true
+	}
+	if _, ok := p.Value.(bool); ok {
+		return true
+	}
+
+	// numbers compared with a string literal are compared as strings, which does not keep their order
+	if _, ok := p.Value.(string); ok && t >= datatype.FLOAT64 && t <= datatype.UINT64 {
+		return true
+	}
+
+	switch p.Operator {
+	case datatype.EQ:
+		return !datatype.LTFunc(p.Value, min).(bool) && !datatype.GTFunc(p.Value, max).(bool)
+	case datatype.NEQ:
+		return !(datatype.EQFunc(min, max).(bool) && datatype.EQFunc(min, p.Value).(bool))
+	case datatype.LT:
+		return datatype.LTFunc(min, p.Value).(bool)
+	case datatype.LTE:
+		return datatype.LTEFunc(min, p.Value).(bool)
+	case datatype.GT:
+		return datatype.GTFunc(max, p.Value).(bool)
+	case datatype.GTE:
+		return datatype.GTEFunc(max, p.Value).(bool)
+	}
+	return true
+}
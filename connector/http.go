@@ -1,18 +1,21 @@
 package connector
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/pkg/jsonpath"
 	"io"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/gotodb/gotodb/config"
 	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/row"
 )
@@ -23,6 +26,9 @@ type Http struct {
 	Partition *partition.Partition
 }
 
+// httpTables holds the tables of the http catalogs.
+var httpTables config.Tables[config.HttpConnectors, config.HttpConnector]
+
 func NewHttpConnectorEmpty() *Http {
 	return &Http{}
 }
@@ -31,7 +37,7 @@ func NewHttpConnector(catalog, schema, table string) (*Http, error) {
 	var err error
 	res := &Http{}
 	key := strings.Join([]string{catalog, schema, table}, ".")
-	conf := config.Conf.HttpConnectors.GetConfig(key)
+	conf := httpTables.Get().GetConfig(key)
 	if conf == nil {
 		return nil, fmt.Errorf("http connector: table not found")
 	}
@@ -74,30 +80,53 @@ func (c *Http) GetPartition(partitionNumber int) (*partition.Partition, error) {
 	return c.Partition, nil
 }
 
-func (c *Http) GetReader(file *partition.FileLocation, md *metadata.Metadata, filters []string) (row.GroupReader, error) {
-	var _http string
-	re := regexp.MustCompile(fmt.Sprintf(`%s\s*=\s*["']{[^{}]*}["']`, c.Config.FilterColumn))
-
-	for _, filter := range filters {
-		_httpExpression := re.FindString(filter)
-		if _httpExpression != "" {
-			_httpOption := strings.SplitN(_httpExpression, "=", 2)
-			_http = strings.Trim(_httpOption[1], " '\"")
-			break
+// optionsFilter returns the index of the filter that sets the request options of a scan, -1 if there is none.
+func (c *Http) optionsFilter(filters []*filter.Predicate) int {
+	for i, p := range filters {
+		if p.Kind == filter.Compare && p.Column == c.Config.FilterColumn && p.Operator == datatype.EQ {
+			if _, ok := p.Values[0].(string); ok {
+				return i
+			}
 		}
 	}
+	return -1
+}
+
+// HandledFilters takes the filter with the request options, as every row of the response carries them.
+func (c *Http) HandledFilters(filters []*filter.Predicate) []bool {
+	res := make([]bool, len(filters))
+	if i := c.optionsFilter(filters); i >= 0 {
+		res[i] = true
+	}
+	return res
+}
+
+func (c *Http) GetReader(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate) (row.GroupReader, error) {
+	var _http string
+	if i := c.optionsFilter(filters); i >= 0 {
+		_http = filters[i].Values[0].(string)
+	}
 	var part, partitionNumber int
 	_, _ = fmt.Sscanf(file.Location, "%d/%d", &part, &partitionNumber)
 
 	type Options struct {
-		URL         string        `json:"url"`
-		URI         string        `json:"uri"`
-		DataPath    string        `json:"dataPath"`
-		Timeout     time.Duration `json:"timeout"`
-		Method      string        `json:"method"`
-		PartitionBy string        `json:"partitionBy"`
-		ContentType string        `json:"contentType"`
-		Body        string        `json:"body"`
+		URL         string          `json:"url"`
+		URI         string          `json:"uri"`
+		DataPath    string          `json:"dataPath"`
+		Timeout     time.Duration   `json:"timeout"`
+		Method      string          `json:"method"`
+		PartitionBy string          `json:"partitionBy"`
+		ContentType string          `json:"contentType"`
+		Body        string          `json:"body"`
+		Pagination  *HttpPagination `json:"pagination"`
+		// Headers add to the configured ones and Auth replaces the configured one
+		Headers map[string]string `json:"headers"`
+		Auth    *config.HttpAuth  `json:"auth"`
+		// Format is json, ndjson or csv, by default taken from the content type of the response
+		Format string `json:"format"`
+		// Retries of 429 and 5xx responses, HttpRetries if unset, with a Backoff in milliseconds
+		Retries *int          `json:"retries"`
+		Backoff time.Duration `json:"backoff"`
 	}
 
 	var options Options
@@ -105,6 +134,13 @@ func (c *Http) GetReader(file *partition.FileLocation, md *metadata.Metadata, fi
 		return nil, err
 	}
 
+	if options.PartitionBy == "" && part > 0 {
+		// the request isn't split, the first partition reads all of it and all of its pages
+		return func(_ []int) (*row.RowsGroup, error) {
+			return nil, io.EOF
+		}, nil
+	}
+
 	body := ""
 	if options.PartitionBy != "" {
 		if options.Body != "" {
@@ -196,102 +232,264 @@ func (c *Http) GetReader(file *partition.FileLocation, md *metadata.Metadata, fi
 		}
 	}
 
-	var stop error
-	return func(indexes []int) (*row.RowsGroup, error) {
-		if stop != nil {
-			return nil, stop
-		}
+	client := &http.Client{}
+	if options.Timeout > 0 {
+		client.Timeout = options.Timeout * time.Millisecond
+	}
 
-		client := &http.Client{}
-		if options.Timeout > 0 {
-			client.Timeout = options.Timeout * time.Millisecond
+	separator := "?"
+	if strings.HasSuffix(options.URL, "?") {
+		separator = "&"
+	} else if strings.HasSuffix(options.URL, "&") || options.URI == "" {
+		separator = ""
+	}
+	u := options.URL + separator + options.URI
+	var page *url.URL
+	if options.Pagination != nil {
+		var err error
+		if page, err = url.Parse(u); err != nil {
+			return nil, err
 		}
-
-		separator := "?"
-		if strings.HasSuffix(options.URL, "?") {
-			separator = "&"
-		} else if strings.HasSuffix(options.URL, "&") || options.URI == "" {
-			separator = ""
+		if page, err = options.Pagination.First(page); err != nil {
+			return nil, err
 		}
+		u = page.String()
+	}
 
-		u := options.URL + separator + options.URI
-		req, err := http.NewRequest(strings.ToUpper(options.Method), u, strings.NewReader(body))
-		if err != nil {
+	if options.DataPath == "" {
+		options.DataPath = c.Config.DataPath
+	}
+	dataPath, err := jsonpath.Compile(options.DataPath)
+	if err != nil {
+		return nil, err
+	}
+	columnPaths := map[string]*jsonpath.Path{}
+	for name, expression := range c.Config.ColumnPaths {
+		if columnPaths[name], err = jsonpath.Compile(expression); err != nil {
 			return nil, err
 		}
-		resp, err := client.Do(req)
+	}
+
+	auth := c.Config.Auth
+	if options.Auth != nil {
+		auth = options.Auth
+	}
+	retries, backoff := HttpRetries, HttpBackoff
+	if options.Retries != nil {
+		retries = *options.Retries
+	}
+	if options.Backoff > 0 {
+		backoff = options.Backoff * time.Millisecond
+	}
+
+	// every call reads one page, the last one sets stop
+	var stop error
+	pages := 0
+	return func(indexes []int) (*row.RowsGroup, error) {
+		if stop != nil {
+			return nil, stop
+		}
+
+		resp, respBody, err := httpDo(client, func() (*http.Request, error) {
+			req, err := http.NewRequest(strings.ToUpper(options.Method), u, strings.NewReader(body))
+			if err != nil {
+				return nil, err
+			}
+			if body != "" && options.ContentType != "" {
+				req.Header.Set("Content-Type", options.ContentType)
+			}
+			if err = httpAuthorize(req, c.Config.Headers, auth); err != nil {
+				return nil, err
+			}
+			return req, httpAuthorize(req, options.Headers, nil)
+		}, retries, backoff, true)
 		if err != nil {
 			return nil, err
 		}
-		respBody, err := io.ReadAll(resp.Body)
+		iResp, err := httpDecode(respBody, resp.Header.Get("Content-Type"), options.Format)
 		if err != nil {
 			return nil, err
 		}
-		_ = resp.Body.Close()
-		var iResp interface{}
-		switch resp.Header.Get("Content-Type") {
-		case "application/json":
-			if err := json.Unmarshal(respBody, &iResp); err != nil {
-				return nil, err
-			}
-		}
 
 		rg := row.NewRowsGroup(md.SelectColumnsByIndexes(indexes))
-		var iData = iResp
-		if options.DataPath != "" {
-			dataPaths := strings.Split(options.DataPath, ".")
-			for _, path := range dataPaths {
-				if data, ok := iData.(map[string]interface{}); !ok {
-					return rg, fmt.Errorf("response data type error, not json object %v", iResp)
-				} else if iData, ok = data[path]; !ok {
-					return rg, fmt.Errorf("response data path error, %v not exsits data path %s", iResp, path)
-				}
-			}
-
+		items, err := httpItems(iResp, dataPath)
+		if err != nil {
+			return rg, err
 		}
-
-	typeAssert:
-		switch iData.(type) {
-		case map[string]interface{}:
-			iData = []interface{}{iData}
-			goto typeAssert
-		case []interface{}:
-			for _, item := range iData.([]interface{}) {
-				var data map[string]interface{}
-				var ok bool
-				if data, ok = item.(map[string]interface{}); !ok {
-					return rg, fmt.Errorf("response data type error, can not assert %v", item)
-				}
-				for _, index := range indexes {
-					col := rg.Metadata.Columns[index]
-					if col.ColumnName == c.Config.FilterColumn {
-						rg.Vals[index] = append(rg.Vals[index], _http)
-					} else if col.ColumnName == c.Config.ResultColumn {
-						rg.Vals[index] = append(rg.Vals[index], string(respBody))
-					} else if value, ok := data[col.ColumnName]; ok {
-						rg.Vals[index] = append(rg.Vals[index], datatype.ToValue(value, md.Columns[index].ColumnType))
-					} else {
-						rg.Vals[index] = append(rg.Vals[index], nil)
+		for _, item := range items {
+			data, ok := item.(map[string]interface{})
+			if !ok {
+				return rg, fmt.Errorf("response data type error, can not assert %v", item)
+			}
+			for _, index := range indexes {
+				col := rg.Metadata.Columns[index]
+				if col.ColumnName == c.Config.FilterColumn {
+					rg.Vals[index] = append(rg.Vals[index], _http)
+				} else if col.ColumnName == c.Config.ResultColumn {
+					rg.Vals[index] = append(rg.Vals[index], string(respBody))
+				} else if path, ok := columnPaths[col.ColumnName]; ok {
+					var value interface{}
+					if values := path.Get(data); len(values) > 0 {
+						value = datatype.ToValue(values[0], md.Columns[index].ColumnType)
 					}
+					rg.Vals[index] = append(rg.Vals[index], value)
+				} else if value, ok := data[col.ColumnName]; ok {
+					rg.Vals[index] = append(rg.Vals[index], datatype.ToValue(value, md.Columns[index].ColumnType))
+				} else {
+					rg.Vals[index] = append(rg.Vals[index], nil)
 				}
-				rg.RowsNumber++
 			}
+			rg.RowsNumber++
 		}
 
 		stop = io.EOF
+		if page != nil {
+			pages++
+			if page, err = options.Pagination.Next(page, resp.Header, iResp, rg.RowsNumber, pages); err != nil {
+				return nil, err
+			}
+			if page != nil {
+				stop = nil
+				u = page.String()
+			}
+		}
 		return rg, nil
 	}, nil
 }
 
-func (c *Http) Insert(rb *row.RowsBuffer, Columns []string) (affectedRows int64, err error) {
+// httpItems returns the items of a response, the values a path with wildcards, slices or filters
+// selects, or else the array or single object at the path.
+func httpItems(resp interface{}, path *jsonpath.Path) ([]interface{}, error) {
+	values := path.Get(resp)
+	if !path.Definite() {
+		return values, nil
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("response data path error, %v not exsits data path %s", resp, path.Expression)
+	}
+	switch tv := values[0].(type) {
+	case []interface{}:
+		return tv, nil
+	case map[string]interface{}:
+		return []interface{}{tv}, nil
+	}
+	return nil, nil
+}
+
+// Insert sends the rows to the sink of the table, the affected rows are those of the requests that
+// succeeded. Without columns all of the table are sent but the filter and result columns. Delivery
+// is at least once: a failed insert keeps the batches sent before it, and a batch retried after a
+// 5xx response, with RetryErrors of the sink, may have been stored already.
+func (c *Http) Insert(rb *row.RowsBuffer, columns []string) (affectedRows int64, err error) {
+	sink := c.Config.Sink
+	if sink == nil {
+		return 0, fmt.Errorf("http connector: table %s.%s.%s has no sink", c.Config.Catalog, c.Config.Schema, c.Config.Table)
+	}
+	if len(columns) == 0 {
+		for _, column := range c.Metadata.Columns {
+			if column.ColumnName != c.Config.FilterColumn && column.ColumnName != c.Config.ResultColumn {
+				columns = append(columns, column.ColumnName)
+			}
+		}
+	}
 
-	return 0, fmt.Errorf("can not insert into http conn")
+	var tmpl *template.Template
+	if sink.Template != "" {
+		if tmpl, err = template.New(c.Config.Table).Funcs(httpTemplateFuncs).Parse(sink.Template); err != nil {
+			return 0, fmt.Errorf("http connector: sink template: %v", err)
+		}
+	}
+	method, contentType := strings.ToUpper(sink.Method), sink.ContentType
+	if method == "" {
+		method = http.MethodPost
+	}
+	if contentType == "" && tmpl == nil {
+		contentType = "application/json"
+	}
+	batchSize := sink.BatchSize
+	if batchSize == 0 {
+		batchSize = HttpBatchSize
+	}
+	client := &http.Client{Timeout: time.Duration(sink.Timeout) * time.Millisecond}
+	retries, backoff := HttpRetries, HttpBackoff
+	if sink.Retries != nil {
+		retries = *sink.Retries
+	}
+	if sink.Backoff > 0 {
+		backoff = time.Duration(sink.Backoff) * time.Millisecond
+	}
+
+	var items []map[string]interface{}
+	send := func() error {
+		if len(items) == 0 {
+			return nil
+		}
+		var data interface{} = items
+		if batchSize == 1 {
+			data = items[0]
+		}
+		var body bytes.Buffer
+		if tmpl != nil {
+			if err := tmpl.Execute(&body, data); err != nil {
+				return fmt.Errorf("http connector: sink template: %v", err)
+			}
+		} else if err := json.NewEncoder(&body).Encode(data); err != nil {
+			return err
+		}
+		if _, _, err := httpDo(client, func() (*http.Request, error) {
+			req, err := http.NewRequest(method, sink.URL, bytes.NewReader(body.Bytes()))
+			if err != nil {
+				return nil, err
+			}
+			if contentType != "" {
+				req.Header.Set("Content-Type", contentType)
+			}
+			return req, httpAuthorize(req, c.Config.Headers, c.Config.Auth)
+		}, retries, backoff, sink.RetryErrors); err != nil {
+			return err
+		}
+		affectedRows += int64(len(items))
+		items = items[:0]
+		return nil
+	}
+
+	for {
+		rg, err := rb.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return affectedRows, err
+		}
+		if len(rg.Vals) < len(columns) {
+			return affectedRows, fmt.Errorf("http connector: %d values for %d columns", len(rg.Vals), len(columns))
+		}
+
+		for r := 0; r < rg.RowsNumber; r++ {
+			item := make(map[string]interface{}, len(columns))
+			for i, column := range columns {
+				value := rg.Vals[i][r]
+				switch v := value.(type) {
+				case datatype.Date:
+					value = v.String()
+				case datatype.Timestamp:
+					value = v.String()
+				}
+				item[column] = value
+			}
+			if items = append(items, item); len(items) >= batchSize {
+				if err = send(); err != nil {
+					return affectedRows, err
+				}
+			}
+		}
+	}
+	return affectedRows, send()
 }
 
 func (c *Http) ShowSchemas(catalog string, _, _ *string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key := range config.Conf.HttpConnectors {
+	for key := range httpTables.Get() {
 		ns := strings.Split(key, ".")
 		c, s, _ := ns[0], ns[1], ns[2]
 		if c == catalog {
@@ -317,7 +515,7 @@ func (c *Http) ShowSchemas(catalog string, _, _ *string) row.Reader {
 func (c *Http) ShowTables(catalog, schema string, _, _ *string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key := range config.Conf.HttpConnectors {
+	for key := range httpTables.Get() {
 		ns := strings.Split(key, ".")
 		c, s, t := ns[0], ns[1], ns[2]
 		if c == catalog && s == schema {
@@ -343,7 +541,7 @@ func (c *Http) ShowTables(catalog, schema string, _, _ *string) row.Reader {
 func (c *Http) ShowColumns(catalog, schema, table string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key, conf := range config.Conf.HttpConnectors {
+	for key, conf := range httpTables.Get() {
 		ns := strings.Split(key, ".")
 		c, s, t := ns[0], ns[1], ns[2]
 		if c == catalog && s == schema && t == table {
@@ -375,3 +573,14 @@ func (c *Http) ShowPartitions(_, _, _ string) row.Reader {
 		return nil, io.EOF
 	}
 }
+
+func init() {
+	Register("http", tablesFactory(Factory{
+		New: func(catalog, schema, table string) (Connector, error) {
+			return NewHttpConnector(catalog, schema, table)
+		},
+		Empty: func() Connector { return NewHttpConnectorEmpty() },
+	}, &httpTables, config.HttpConnectors.Check, func(c *config.HttpConnector, catalog string) {
+		c.Catalog = catalog
+	}))
+}
@@ -0,0 +1,172 @@
+package connector
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gotodb/gotodb/pkg/jsonpath"
+)
+
+// HttpPagination is how the pages of a paginated api follow each other, reading stops at the first
+// page without rows, without a further page, or after MaxPages pages.
+type HttpPagination struct {
+	// Type is "cursor" for a cursor in the response sent back in Param, "next" for the url of the next
+	// page in the response, "link" for the next link of the Link header, or "offset" and "page" for an
+	// offset or page number in Param
+	Type string `json:"type"`
+	// Path is the JSONPath of the cursor or next page url in the response
+	Path  string `json:"path"`
+	Param string `json:"param"`
+	// Start is the first offset or page number, Size the rows of a full page which is sent in SizeParam
+	// if that is set. An offset or page type reading stops after a page with fewer rows than Size.
+	Start     int64  `json:"start"`
+	Size      int64  `json:"size"`
+	SizeParam string `json:"sizeParam"`
+	MaxPages  int    `json:"maxPages"`
+}
+
+// First returns the url of the first page.
+func (p *HttpPagination) First(u *url.URL) (*url.URL, error) {
+	switch p.Type {
+	case "cursor", "offset", "page":
+		if p.Param == "" {
+			return nil, fmt.Errorf("http connector: %s pagination without param", p.Type)
+		}
+	case "next":
+		if p.Path == "" {
+			return nil, fmt.Errorf("http connector: next pagination without path")
+		}
+	case "link":
+	default:
+		return nil, fmt.Errorf("http connector: unknown pagination type %s", p.Type)
+	}
+	if _, err := jsonpath.Compile(p.Path); err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	if p.Type == "offset" || p.Type == "page" {
+		q.Set(p.Param, strconv.FormatInt(p.Start, 10))
+	}
+	if p.SizeParam != "" && p.Size > 0 {
+		q.Set(p.SizeParam, strconv.FormatInt(p.Size, 10))
+	}
+	return withQuery(u, q), nil
+}
+
+// Next returns the url of the page after the one at u, nil if it was the last one.
+func (p *HttpPagination) Next(u *url.URL, header http.Header, body interface{}, rows, pages int) (*url.URL, error) {
+	if rows == 0 || p.MaxPages > 0 && pages >= p.MaxPages {
+		return nil, nil
+	}
+
+	var next *url.URL
+	q := u.Query()
+	switch p.Type {
+	case "cursor":
+		cursor, ok := httpPath(body, p.Path)
+		if !ok || httpString(cursor) == "" {
+			return nil, nil
+		}
+		q.Set(p.Param, httpString(cursor))
+		next = withQuery(u, q)
+
+	case "next":
+		link, ok := httpPath(body, p.Path)
+		if !ok || httpString(link) == "" {
+			return nil, nil
+		}
+		var err error
+		if next, err = u.Parse(httpString(link)); err != nil {
+			return nil, fmt.Errorf("http connector: next page url: %v", err)
+		}
+
+	case "link":
+		link := nextLink(header.Values("Link"))
+		if link == "" {
+			return nil, nil
+		}
+		var err error
+		if next, err = u.Parse(link); err != nil {
+			return nil, fmt.Errorf("http connector: next link: %v", err)
+		}
+
+	case "offset", "page":
+		if p.Size > 0 && int64(rows) < p.Size {
+			return nil, nil
+		}
+		current, err := strconv.ParseInt(q.Get(p.Param), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("http connector: %s %s: %v", p.Type, p.Param, err)
+		}
+		if p.Type == "offset" {
+			current += int64(rows)
+		} else {
+			current++
+		}
+		q.Set(p.Param, strconv.FormatInt(current, 10))
+		next = withQuery(u, q)
+	}
+
+	if next.String() == u.String() {
+		return nil, nil
+	}
+	return next, nil
+}
+
+func withQuery(u *url.URL, q url.Values) *url.URL {
+	res := *u
+	res.RawQuery = q.Encode()
+	return &res
+}
+
+var linkRegexp = regexp.MustCompile(`<([^>]*)>\s*((?:;\s*[^;,]*)*)`)
+
+// nextLink returns the target of the rel="next" link of Link headers.
+func nextLink(headers []string) string {
+	for _, header := range headers {
+		for _, match := range linkRegexp.FindAllStringSubmatch(header, -1) {
+			for _, param := range strings.Split(match[2], ";") {
+				name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.EqualFold(name, "rel") {
+					for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+						if strings.EqualFold(rel, "next") {
+							return match[1]
+						}
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// httpPath returns the first value a JSONPath selects in a decoded json value.
+func httpPath(v interface{}, expression string) (interface{}, bool) {
+	path, err := jsonpath.Compile(expression)
+	if err != nil {
+		return nil, false
+	}
+	values := path.Get(v)
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// httpString formats a decoded json scalar, numbers without an exponent.
+func httpString(v interface{}) string {
+	switch tv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return tv
+	case float64:
+		return strconv.FormatFloat(tv, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,181 @@
+package connector
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gotodb/gotodb/config"
+)
+
+const (
+	// HttpRetries and HttpBackoff are the defaults of the retries of a request answered with 429 or
+	// 5xx, the backoff doubles with every retry unless a Retry-After header tells the delay
+	HttpRetries = 3
+	HttpBackoff = 200 * time.Millisecond
+	// HttpBatchSize is the default of the rows an insert sends per request
+	HttpBatchSize = 100
+	// httpExcerpt bounds the part of an error response quoted in the error
+	httpExcerpt = 512
+)
+
+// httpTemplateFuncs are the functions of a sink template.
+var httpTemplateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		res, err := json.Marshal(v)
+		return string(res), err
+	},
+}
+
+// httpAuthorize adds the headers and the authentication of a request.
+func httpAuthorize(req *http.Request, headers map[string]string, auth *config.HttpAuth) error {
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	if err := auth.Check(); err != nil || auth == nil {
+		return err
+	}
+	switch auth.Type {
+	case "bearer":
+		req.Header.Set("Authorization", "Bearer "+auth.Token)
+	case "basic":
+		req.SetBasicAuth(auth.Username, auth.Password)
+	case "api-key":
+		name := auth.Name
+		if auth.In == "query" {
+			if name == "" {
+				name = "api_key"
+			}
+			q := req.URL.Query()
+			q.Set(name, auth.Token)
+			req.URL.RawQuery = q.Encode()
+		} else {
+			if name == "" {
+				name = "X-API-Key"
+			}
+			req.Header.Set(name, auth.Token)
+		}
+	}
+	return nil
+}
+
+// httpDo sends the request newRequest makes and returns the response with its body, which must
+// have a 2xx status. Responses with 429, and with 5xx if serverErrors, are retried up to retries
+// times.
+func httpDo(client *http.Client, newRequest func() (*http.Request, error), retries int, backoff time.Duration, serverErrors bool) (*http.Response, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, body, nil
+		}
+
+		if (resp.StatusCode == http.StatusTooManyRequests || serverErrors && resp.StatusCode >= 500) && attempt < retries {
+			delay := backoff << attempt
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+				delay = time.Duration(seconds) * time.Second
+			}
+			time.Sleep(delay)
+			continue
+		}
+
+		excerpt := string(body)
+		if len(excerpt) > httpExcerpt {
+			excerpt = excerpt[:httpExcerpt] + "..."
+		}
+		u := *req.URL
+		u.RawQuery, u.User = "", nil
+		return nil, nil, fmt.Errorf("http connector: %s %s: %s: %s", req.Method, u.String(), resp.Status, excerpt)
+	}
+}
+
+// httpDecode decodes a response body by the format, which is json, ndjson or csv and taken from
+// the content type if it is empty. A ndjson body becomes the list of its values and a csv body
+// the list of objects of its rows, keyed by the header row. An unknown content type gives nil.
+func httpDecode(body []byte, contentType, format string) (interface{}, error) {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if format == "" {
+		switch {
+		case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+			format = "json"
+		case mediaType == "application/x-ndjson" || mediaType == "application/ndjson" ||
+			mediaType == "application/jsonl" || mediaType == "application/x-jsonlines":
+			format = "ndjson"
+		case mediaType == "text/csv" || mediaType == "application/csv":
+			format = "csv"
+		default:
+			return nil, nil
+		}
+	}
+
+	switch charset := strings.ToLower(params["charset"]); charset {
+	case "", "utf-8", "utf8", "us-ascii":
+	case "iso-8859-1", "latin1":
+		runes := make([]rune, len(body))
+		for i, b := range body {
+			runes[i] = rune(b)
+		}
+		body = []byte(string(runes))
+	default:
+		return nil, fmt.Errorf("http connector: unsupported charset %s", charset)
+	}
+
+	var res interface{}
+	switch format {
+	case "json":
+		if err := json.Unmarshal(body, &res); err != nil {
+			return nil, err
+		}
+	case "ndjson":
+		var items []interface{}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		for {
+			var item interface{}
+			if err := decoder.Decode(&item); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		res = items
+	case "csv":
+		records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		var items []interface{}
+		for i := 1; i < len(records); i++ {
+			item := map[string]interface{}{}
+			for j, name := range records[0] {
+				if j < len(records[i]) && records[i][j] != "" {
+					item[name] = records[i][j]
+				}
+			}
+			items = append(items, item)
+		}
+		res = items
+	default:
+		return nil, fmt.Errorf("http connector: unknown format %s", format)
+	}
+	return res, nil
+}
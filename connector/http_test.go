@@ -0,0 +1,311 @@
+package connector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+// httpRead reads all rows of an http table scan with the given _http options.
+func httpRead(options string) ([]interface{}, int, error) {
+	return httpReadPartition("0/1", options)
+}
+
+// httpReadPartition reads the rows of one partition, part/partition number, of an http table scan.
+func httpReadPartition(location, options string) ([]interface{}, int, error) {
+	conf := &config.HttpConnector{
+		Catalog: "http", Schema: "api", Table: "items", FilterColumn: "_http", ResultColumn: "_",
+		Headers:     map[string]string{"X-Team": "data"},
+		ColumnNames: []string{"id"}, ColumnTypes: []string{"INT64"},
+	}
+	md, err := NewHttpMetadata(conf)
+	if err != nil {
+		return nil, 0, err
+	}
+	c := &Http{Config: conf, Metadata: md}
+	filters := []*filter.Predicate{filter.NewCompare("_http", datatype.EQ, options)}
+	reader, err := c.GetReader(partition.NewFileLocation(location, partition.FileTypeUnknown), md, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var ids []interface{}
+	groups := 0
+	for {
+		rg, err := reader([]int{0})
+		if err == io.EOF {
+			return ids, groups, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		ids = append(ids, rg.Vals[0]...)
+		groups++
+	}
+}
+
+func TestHttpPagination(t *testing.T) {
+	items := make([]map[string]interface{}, 5)
+	for i := range items {
+		items[i] = map[string]interface{}{"id": i}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		q := r.URL.Query()
+		var start, end int
+		var body map[string]interface{}
+		switch r.URL.Path {
+		case "/cursor":
+			start, _ = strconv.Atoi(q.Get("after"))
+			end = start + 2
+			body = map[string]interface{}{"meta": map[string]interface{}{"next": end}}
+			if end >= len(items) {
+				end = len(items)
+				body["meta"] = map[string]interface{}{"next": nil}
+			}
+		case "/link":
+			start, _ = strconv.Atoi(q.Get("from"))
+			end = start + 2
+			if end < len(items) {
+				w.Header().Add("Link", fmt.Sprintf(`</other>; rel="prev", </link?from=%d>; rel="next"`, end))
+			} else {
+				end = len(items)
+			}
+			body = map[string]interface{}{}
+		case "/page":
+			page, _ := strconv.Atoi(q.Get("page"))
+			size, _ := strconv.Atoi(q.Get("per_page"))
+			start, end = (page-1)*size, page*size
+			if start > len(items) {
+				start = len(items)
+			}
+			if end > len(items) {
+				end = len(items)
+			}
+			body = map[string]interface{}{}
+		}
+		body["data"] = items[start:end]
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		options     string
+		pages, rows int
+	}{
+		{`{"url": "%s/cursor", "dataPath": "data", "pagination": {"type": "cursor", "path": "meta.next", "param": "after"}}`, 3, 5},
+		{`{"url": "%s/link", "dataPath": "data", "pagination": {"type": "link"}}`, 3, 5},
+		{`{"url": "%s/page", "dataPath": "data", "pagination": {"type": "page", "param": "page", "start": 1, "size": 2, "sizeParam": "per_page"}}`, 3, 5},
+		{`{"url": "%s/page", "dataPath": "data", "pagination": {"type": "page", "param": "page", "start": 1, "size": 2, "sizeParam": "per_page", "maxPages": 2}}`, 2, 4},
+		{`{"url": "%s/page?page=1&per_page=5", "dataPath": "data"}`, 1, 5},
+	}
+	for _, c := range cases {
+		ids, groups, err := httpRead(fmt.Sprintf(c.options, server.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if groups != c.pages || len(ids) != c.rows || ids[0] != int64(0) || ids[len(ids)-1] != int64(c.rows-1) {
+			t.Errorf("%s: unexpected rows %v in %d groups", c.options, ids, groups)
+		}
+	}
+
+	// without partitionBy only the first partition requests the pages
+	for location, rows := range map[string]int{"0/3": 5, "1/3": 0, "2/3": 0} {
+		ids, _, err := httpReadPartition(location, fmt.Sprintf(cases[0].options, server.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != rows {
+			t.Errorf("%s: unexpected rows %v", location, ids)
+		}
+	}
+}
+
+func TestHttpRequests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ndjson":
+			if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Team") != "data" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/x-ndjson; charset=utf-8")
+			_, _ = io.WriteString(w, "{\"id\": 0}\n{\"id\": 1}\n")
+		case "/csv":
+			if user, password, ok := r.BasicAuth(); !ok || user != "u" || password != "p" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = io.WriteString(w, "name,id\na,0\nb,1\nc,2\n")
+		case "/flaky":
+			if attempts++; attempts < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = io.WriteString(w, `[{"id": 0}]`)
+		default:
+			http.Error(w, "no such items", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		options string
+		rows    int
+	}{
+		{`{"url": "%s/ndjson", "auth": {"type": "bearer", "token": "secret"}}`, 2},
+		{`{"url": "%s/csv", "auth": {"type": "basic", "username": "u", "password": "p"}}`, 3},
+		{`{"url": "%s/flaky"}`, 1},
+	}
+	for _, c := range cases {
+		ids, _, err := httpRead(fmt.Sprintf(c.options, server.URL))
+		if err != nil || len(ids) != c.rows || ids[c.rows-1] != int64(c.rows-1) {
+			t.Errorf("%s: unexpected rows %v, %v", c.options, ids, err)
+		}
+	}
+
+	attempts = 0
+	if _, _, err := httpRead(fmt.Sprintf(`{"url": "%s/flaky", "retries": 1}`, server.URL)); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected a 503 error, got %v", err)
+	}
+	if _, _, err := httpRead(fmt.Sprintf(`{"url": "%s/missing?key=secret"}`, server.URL)); err == nil ||
+		!strings.Contains(err.Error(), "404 Not Found: no such items") || strings.Contains(err.Error(), "secret") {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+}
+
+func TestHttpPaths(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"groups": [
+			{"users": [{"id": 1, "address": {"city": "Oslo"}}, {"id": 2, "active": false}]},
+			{"users": [{"id": 3, "address": {"city": "Rome"}}]}
+		]}`)
+	}))
+	defer server.Close()
+
+	conf := &config.HttpConnector{
+		Catalog: "http", Schema: "api", Table: "users", FilterColumn: "_http", ResultColumn: "_",
+		DataPath:    "groups[*].users[?(@.active != false)]",
+		ColumnPaths: map[string]string{"city": "address.city"},
+		ColumnNames: []string{"id", "city"}, ColumnTypes: []string{"INT64", "STRING"},
+	}
+	md, err := NewHttpMetadata(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Http{Config: conf, Metadata: md}
+	filters := []*filter.Predicate{filter.NewCompare("_http", datatype.EQ, fmt.Sprintf(`{"url": "%s"}`, server.URL))}
+	reader, err := c.GetReader(partition.NewFileLocation("0/1", partition.FileTypeUnknown), md, filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rg, err := reader([]int{0, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rg.RowsNumber != 2 || rg.Vals[0][1] != int64(3) || rg.Vals[1][0] != "Oslo" || rg.Vals[1][1] != "Rome" {
+		t.Fatalf("unexpected rows %v", rg.Vals)
+	}
+}
+
+func TestHttpInsert(t *testing.T) {
+	var bodies []string
+	failures, status := 0, http.StatusBadGateway
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if failures > 0 {
+			failures--
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, r.Method+" "+r.Header.Get("Content-Type")+" "+strings.TrimSpace(string(body)))
+	}))
+	defer server.Close()
+
+	insert := func(sink *config.HttpSink, columns []string) (int64, error) {
+		conf := &config.HttpConnector{
+			Catalog: "http", Schema: "hooks", Table: "events", FilterColumn: "_http", ResultColumn: "_",
+			Auth:        &config.HttpAuth{Type: "bearer", Token: "secret"},
+			ColumnNames: []string{"id", "name", "day"}, ColumnTypes: []string{"INT64", "STRING", "DATE"},
+			Sink: sink,
+		}
+		md, err := NewHttpMetadata(conf)
+		if err != nil {
+			return 0, err
+		}
+		in := row.NewRowsGroup(md.SelectColumnsByIndexes([]int{0, 1, 2}))
+		for i := int64(1); i <= 3; i++ {
+			in.AppendRowVals(i, fmt.Sprintf("e%d", i), datatype.Date{Sec: time.Date(2023, 11, 14+int(i), 0, 0, 0, 0, time.Local).Unix()})
+		}
+		var buf bytes.Buffer
+		rb := row.NewRowsBuffer(in.Metadata, &buf, &buf)
+		if err := rb.Write(in); err != nil {
+			return 0, err
+		}
+		if err := rb.Flush(); err != nil {
+			return 0, err
+		}
+		c := &Http{Config: conf, Metadata: md}
+		return c.Insert(rb, columns)
+	}
+
+	failures = 1
+	if n, err := insert(&config.HttpSink{URL: server.URL, BatchSize: 2, RetryErrors: true}, nil); err != nil || n != 3 {
+		t.Fatalf("unexpected insert of %d rows, %v", n, err)
+	}
+	if n, err := insert(&config.HttpSink{URL: server.URL, Method: "put", BatchSize: 1,
+		ContentType: "text/plain", Template: `{{.id}}={{json .name}}`}, []string{"id", "name"}); err != nil || n != 3 {
+		t.Fatalf("unexpected insert of %d rows, %v", n, err)
+	}
+	expected := []string{
+		`POST application/json [{"day":"2023-11-15","id":1,"name":"e1"},{"day":"2023-11-16","id":2,"name":"e2"}]`,
+		`POST application/json [{"day":"2023-11-17","id":3,"name":"e3"}]`,
+		`PUT text/plain 1="e1"`,
+		`PUT text/plain 2="e2"`,
+		`PUT text/plain 3="e3"`,
+	}
+	if fmt.Sprint(bodies) != fmt.Sprint(expected) {
+		t.Errorf("unexpected requests %q", bodies)
+	}
+
+	// a 5xx response is only retried if the sink allows it, the server may have stored the rows
+	failures = 1
+	if n, err := insert(&config.HttpSink{URL: server.URL, BatchSize: 2}, nil); err == nil || n != 0 {
+		t.Errorf("expected a 502 error, got %d rows, %v", n, err)
+	}
+	failures = 2
+	zero := 0
+	if n, err := insert(&config.HttpSink{URL: server.URL, BatchSize: 2, Retries: &zero, RetryErrors: true}, nil); err == nil || n != 0 {
+		t.Errorf("expected a 502 error, got %d rows, %v", n, err)
+	}
+	failures, status = 1, http.StatusTooManyRequests
+	if n, err := insert(&config.HttpSink{URL: server.URL, BatchSize: 3}, []string{"id"}); err != nil || n != 3 {
+		t.Errorf("unexpected insert of %d rows, %v", n, err)
+	}
+	if _, err := insert(nil, nil); err == nil {
+		t.Error("expected an error without a sink")
+	}
+}
@@ -2,23 +2,34 @@ package connector
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/gotodb/gotodb/partition"
 	"io"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gotodb/gotodb/config"
 	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/row"
+	"gopkg.in/yaml.v3"
 )
 
+// MysqlMaxParameters is the most placeholders a mysql prepared statement takes.
+const MysqlMaxParameters = 65535
+
 type Mysql struct {
 	Config    *config.MysqlConnector
 	Metadata  *metadata.Metadata
 	Partition *partition.Partition
 }
 
+// mysqlTables holds the tables of the mysql catalogs.
+var mysqlTables config.Tables[config.MysqlConnectors, config.MysqlConnector]
+
 func NewMysqlConnectorEmpty() *Mysql {
 	return &Mysql{}
 }
@@ -27,16 +38,98 @@ func NewMysqlConnector(catalog, schema, table string) (*Mysql, error) {
 	var err error
 	res := &Mysql{}
 	key := strings.Join([]string{catalog, schema, table}, ".")
-	conf := config.Conf.MysqlConnectors.GetConfig(key)
+	conf := mysqlTables.Get().GetConfig(key)
 	if conf == nil {
 		return nil, fmt.Errorf("mysql connector: table not found")
 	}
-	res.Config = conf
-	res.Metadata, err = NewMysqlMetadata(conf)
+	temp := *conf
+	temp.Catalog, temp.Schema, temp.Table = catalog, schema, table
+	if len(temp.ColumnNames) == 0 {
+		if temp.ColumnNames, temp.ColumnTypes, err = mysqlColumns(&temp); err != nil {
+			return nil, err
+		}
+		if len(temp.ColumnNames) == 0 {
+			return nil, fmt.Errorf("mysql connector: table not found")
+		}
+	}
+	res.Config = &temp
+	res.Metadata, err = NewMysqlMetadata(&temp)
 
 	return res, err
 }
 
+// DefaultMysqlMetadataTTL is how long discovered columns are cached without a metadata-ttl.
+const DefaultMysqlMetadataTTL = 300 * time.Second
+
+type mysqlTableColumns struct {
+	names   []string
+	types   []string
+	expires time.Time
+}
+
+var (
+	mysqlColumnsMu    sync.Mutex
+	mysqlColumnsCache = map[string]*mysqlTableColumns{}
+)
+
+// mysqlColumns discovers the columns of a table from INFORMATION_SCHEMA and caches them for
+// the metadata-ttl of its config.
+func mysqlColumns(conf *config.MysqlConnector) ([]string, []string, error) {
+	key := strings.Join([]string{conf.Catalog, conf.Schema, conf.Table}, ".")
+	mysqlColumnsMu.Lock()
+	cached, ok := mysqlColumnsCache[key]
+	mysqlColumnsMu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.names, cached.types, nil
+	}
+
+	db, err := (&Mysql{Config: conf}).getDB()
+	if err != nil {
+		return nil, nil, fmt.Errorf("mysql connector: %v", err)
+	}
+	rows, err := db.Query(`SELECT COLUMN_NAME, DATA_TYPE, COLUMN_TYPE FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION`, conf.Schema, conf.Table)
+	if err != nil {
+		return nil, nil, fmt.Errorf("mysql connector: %v", err)
+	}
+	defer rows.Close()
+
+	columns := &mysqlTableColumns{names: []string{}, types: []string{}}
+	var name, dataType, columnType string
+	for rows.Next() {
+		if err = rows.Scan(&name, &dataType, &columnType); err != nil {
+			return nil, nil, fmt.Errorf("mysql connector: %v", err)
+		}
+		columns.names = append(columns.names, name)
+		columns.types = append(columns.types, datatype.FromMysql(dataType, strings.Contains(columnType, "unsigned")).String())
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("mysql connector: %v", err)
+	}
+
+	ttl := DefaultMysqlMetadataTTL
+	if conf.MetadataTTL > 0 {
+		ttl = time.Duration(conf.MetadataTTL) * time.Second
+	}
+	columns.expires = time.Now().Add(ttl)
+	mysqlColumnsMu.Lock()
+	mysqlColumnsCache[key] = columns
+	mysqlColumnsMu.Unlock()
+	return columns.names, columns.types, nil
+}
+
+// RefreshMysqlMetadata drops the cached columns of the tables of a catalog, of all catalogs
+// if it is empty, so that they are discovered again on next use.
+func RefreshMysqlMetadata(catalog string) {
+	mysqlColumnsMu.Lock()
+	defer mysqlColumnsMu.Unlock()
+	for key := range mysqlColumnsCache {
+		if catalog == "" || strings.HasPrefix(key, catalog+".") {
+			delete(mysqlColumnsCache, key)
+		}
+	}
+}
+
 func NewMysqlMetadata(conf *config.MysqlConnector) (*metadata.Metadata, error) {
 	res := metadata.NewMetadata()
 	for i := 0; i < len(conf.ColumnNames); i++ {
@@ -58,30 +151,287 @@ func (c *Mysql) GetMetadata() (*metadata.Metadata, error) {
 	return c.Metadata, nil
 }
 
+// mysqlRange is the range of the split column a scan task reads, encoded as its location.
+// Bounds are inclusive below and exclusive above, nil bounds are open.
+type mysqlRange struct {
+	Column string      `json:"column,omitempty"`
+	Low    interface{} `json:"low,omitempty"`
+	High   interface{} `json:"high,omitempty"`
+	// Null adds the rows whose split column is null
+	Null bool `json:"null,omitempty"`
+}
+
+// MysqlIdentifier backquotes a mysql identifier.
+func MysqlIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+var mysqlDialect = &sqlDialect{
+	Identifier: MysqlIdentifier,
+	Placeholder: func(_ int) string {
+		return "?"
+	},
+	// the usual collations ignore the case and trailing spaces, which also makes LIKE case insensitive
+	Binary: func(column string) string {
+		return "BINARY " + column
+	},
+	Like: true,
+}
+
+// GetPartition splits the table into up to partitionNumber ranges of the split column, which is
+// the configured one or else the first primary key column. Integer columns are split evenly
+// between their MIN and MAX, other columns at boundaries sampled from their sorted values.
 func (c *Mysql) GetPartition(partitionNumber int) (*partition.Partition, error) {
-	if c.Partition == nil {
-		c.Partition = partition.New(metadata.NewMetadata())
-		c.Partition.Locations = append(c.Partition.Locations, fmt.Sprintf("%d/%d", 0, partitionNumber))
+	if c.Partition != nil {
+		return c.Partition, nil
+	}
 
+	ranges := []mysqlRange{{}}
+	if partitionNumber > 1 {
+		db, err := c.getDB()
+		if err != nil {
+			return nil, err
+		}
+
+		column, err := c.splitColumn(db)
+		if err != nil {
+			return nil, err
+		}
+		if column != "" {
+			bounds, err := c.splitBounds(db, column, partitionNumber)
+			if err != nil {
+				return nil, err
+			}
+			if len(bounds) > 0 {
+				ranges = []mysqlRange{{Column: column, High: bounds[0], Null: true}}
+				for i, bound := range bounds {
+					r := mysqlRange{Column: column, Low: bound}
+					if i+1 < len(bounds) {
+						r.High = bounds[i+1]
+					}
+					ranges = append(ranges, r)
+				}
+			}
+		}
+	}
+
+	c.Partition = partition.New(metadata.NewMetadata())
+	for _, r := range ranges {
+		location, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		c.Partition.Locations = append(c.Partition.Locations, string(location))
+		c.Partition.FileTypes = append(c.Partition.FileTypes, partition.FileTypeUnknown)
 	}
 	return c.Partition, nil
 }
 
-func (c *Mysql) GetReader(file *partition.FileLocation, md *metadata.Metadata, filters []string) (row.GroupReader, error) {
+func (c *Mysql) splitColumn(db *sql.DB) (string, error) {
+	if c.Config.SplitColumn != "" {
+		return c.Config.SplitColumn, nil
+	}
+	var column string
+	err := db.QueryRow(`SELECT COLUMN_NAME FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY' ORDER BY ORDINAL_POSITION LIMIT 1`,
+		c.Config.Schema, c.Config.Table).Scan(&column)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return column, err
+}
+
+// splitBounds returns the ascending lower bounds of all but the first range.
+func (c *Mysql) splitBounds(db *sql.DB, column string, partitionNumber int) ([]interface{}, error) {
+	table := MysqlIdentifier(c.Config.Schema) + "." + MysqlIdentifier(c.Config.Table)
+	col := MysqlIdentifier(column)
+	var bounds []interface{}
+
+	t := datatype.UnknownType
+	if index, err := c.Metadata.GetIndexByName(column); err == nil {
+		t = c.Metadata.Columns[index].ColumnType
+	}
+	switch t {
+	case datatype.INT8, datatype.INT16, datatype.INT32, datatype.INT64, datatype.UINT8, datatype.UINT16, datatype.UINT32:
+		var min, max sql.NullInt64
+		if err := db.QueryRow(fmt.Sprintf("SELECT MIN(%s), MAX(%s) FROM %s", col, col, table)).Scan(&min, &max); err != nil {
+			return nil, err
+		}
+		if !min.Valid {
+			return nil, nil
+		}
+		span := uint64(max.Int64-min.Int64) + 1
+		step := span / uint64(partitionNumber)
+		if span%uint64(partitionNumber) != 0 {
+			step++
+		}
+		for i := 1; i < partitionNumber; i++ {
+			offset := step * uint64(i)
+			if offset >= span {
+				break
+			}
+			bounds = append(bounds, min.Int64+int64(offset))
+		}
+
+	default:
+		var count int64
+		if err := db.QueryRow(fmt.Sprintf("SELECT COUNT(%s) FROM %s", col, table)).Scan(&count); err != nil {
+			return nil, err
+		}
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE %s IS NOT NULL ORDER BY %s LIMIT 1 OFFSET ?", col, table, col, col)
+		var last string
+		for i := 1; i < partitionNumber; i++ {
+			offset := count * int64(i) / int64(partitionNumber)
+			if offset == 0 {
+				continue
+			}
+			var bound []byte
+			if err := db.QueryRow(query, offset).Scan(&bound); err != nil {
+				return nil, err
+			}
+			if len(bounds) > 0 && string(bound) == last {
+				continue
+			}
+			last = string(bound)
+			bounds = append(bounds, last)
+		}
+	}
+	return bounds, nil
+}
+
+func (c *Mysql) GetReader(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate) (row.GroupReader, error) {
+	return c.GetPushDownReader(file, md, filters, nil)
+}
+
+func (c *Mysql) HandledFilters(filters []*filter.Predicate) []bool {
+	return sqlHandledFilters(mysqlDialect, filters, c.Metadata)
+}
+
+// CanPushDown takes limits, top-Ns and partial aggregations of COUNT, SUM, MIN and MAX. Strings
+// are neither grouped by nor aggregated by MIN and MAX, the collation of a column may compare
+// strings as equal or ordered that the engine compares by their bytes.
+func (c *Mysql) CanPushDown(pushDown *PushDown) bool {
+	isString := func(column string) bool {
+		t, err := c.Metadata.GetTypeByName(column)
+		return err != nil || t == datatype.STRING
+	}
+	for _, column := range pushDown.GroupBy {
+		if isString(column) {
+			return false
+		}
+	}
+	for _, aggregate := range pushDown.Aggregates {
+		switch aggregate.Func {
+		case "COUNT", "SUM":
+		case "MIN", "MAX":
+			if isString(aggregate.Column) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Mysql) GetPushDownReader(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate, pushDown *PushDown) (row.GroupReader, error) {
+	query, args, err := c.selectQuery(file, md, filters, pushDown)
+	if err != nil {
+		return nil, err
+	}
+	db, err := c.getDB()
+	if err != nil {
+		return nil, err
+	}
+	return sqlReader(db, md, query, args...), nil
+}
+
+// selectQuery returns the query of a scan task and its arguments.
+func (c *Mysql) selectQuery(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate, pushDown *PushDown) (string, []interface{}, error) {
 	alias := ""
 	if c.Config.Table != md.Columns[0].Table {
-		alias = md.Columns[0].Table + "."
+		alias = MysqlIdentifier(md.Columns[0].Table) + "."
 	}
 
-	selectItems := ""
-	for _, column := range md.Columns {
-		selectItems += alias + column.ColumnName + ","
+	var selectItems []string
+	var groupBy, orderBy, limit string
+	if pushDown != nil && len(pushDown.Aggregates) > 0 {
+		grouped := map[string]bool{}
+		var groupItems []string
+		for _, column := range pushDown.GroupBy {
+			grouped[column] = true
+			groupItems = append(groupItems, alias+MysqlIdentifier(column))
+		}
+		// the columns not grouped by are only carried along, any value of the group does
+		for _, column := range md.Columns[:len(md.Columns)-len(pushDown.Aggregates)] {
+			item := alias + MysqlIdentifier(column.ColumnName)
+			if !grouped[column.ColumnName] {
+				item = "MIN(" + item + ")"
+			}
+			selectItems = append(selectItems, item)
+		}
+		for _, aggregate := range pushDown.Aggregates {
+			argument := "*"
+			if aggregate.Column != "" {
+				argument = alias + MysqlIdentifier(aggregate.Column)
+			}
+			selectItems = append(selectItems, aggregate.Func+"("+argument+")")
+		}
+		if len(groupItems) > 0 {
+			groupBy = " group by " + strings.Join(groupItems, ", ")
+		}
+	} else {
+		for _, column := range md.Columns {
+			selectItems = append(selectItems, alias+MysqlIdentifier(column.ColumnName))
+		}
 	}
-	selectItems = strings.Trim(selectItems, ",")
 
-	var clauses []string
-	for _, filter := range filters {
-		clauses = append(clauses, "("+filter+")")
+	if pushDown != nil {
+		var sortItems []string
+		for _, item := range pushDown.OrderBy {
+			sortItem := alias + MysqlIdentifier(item.Column)
+			// the engine compares strings by their bytes, not by a collation
+			if t, err := c.Metadata.GetTypeByName(item.Column); err == nil && t == datatype.STRING {
+				sortItem = "BINARY " + sortItem
+			}
+			if item.Desc {
+				sortItem += " DESC"
+			}
+			sortItems = append(sortItems, sortItem)
+		}
+		if len(sortItems) > 0 {
+			orderBy = " order by " + strings.Join(sortItems, ", ")
+		}
+		if pushDown.Limit != nil {
+			limit = fmt.Sprintf(" limit %d", *pushDown.Limit)
+		}
+	}
+
+	var args []interface{}
+	clauses := sqlWhere(mysqlDialect, filters, c.Metadata, alias, &args)
+
+	var r mysqlRange
+	decoder := json.NewDecoder(strings.NewReader(file.Location))
+	decoder.UseNumber()
+	if err := decoder.Decode(&r); err != nil {
+		return "", nil, fmt.Errorf("mysql connector: invalid location %s: %v", file.Location, err)
+	}
+	if r.Column != "" {
+		col := alias + MysqlIdentifier(r.Column)
+		var bounds []string
+		if r.Low != nil {
+			bounds = append(bounds, col+" >= ?")
+			args = append(args, mysqlBound(r.Low))
+		}
+		if r.High != nil {
+			bounds = append(bounds, col+" < ?")
+			args = append(args, mysqlBound(r.High))
+		}
+		rangeClause := strings.Join(bounds, " AND ")
+		if r.Null {
+			rangeClause = "(" + rangeClause + " OR " + col + " IS NULL)"
+		}
+		clauses = append(clauses, rangeClause)
 	}
 	clause := strings.Join(clauses, " AND ")
 
@@ -89,52 +439,101 @@ func (c *Mysql) GetReader(file *partition.FileLocation, md *metadata.Metadata, f
 		clause = " where " + clause
 	}
 
-	var stop error
-	var part, partitionNumber int
-	_, _ = fmt.Sscanf(file.Location, "%d/%d", &part, &partitionNumber)
-	return func(indexes []int) (*row.RowsGroup, error) {
-		if part > 0 {
-			return nil, io.EOF
-		}
+	from := MysqlIdentifier(c.Config.Schema) + "." + MysqlIdentifier(c.Config.Table)
+	if alias != "" {
+		from += " " + strings.TrimRight(alias, ".")
+	}
+	query := fmt.Sprintf("select %s from %s%s%s%s%s", strings.Join(selectItems, ", "), from, clause, groupBy, orderBy, limit)
+	return query, args, nil
+}
 
-		if stop != nil {
-			return nil, stop
+// mysqlBound turns a decoded range bound back into an integer if it is one.
+func mysqlBound(v interface{}) interface{} {
+	if n, ok := v.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return i
 		}
+		return n.String()
+	}
+	return v
+}
 
-		dsn := c.getDSN()
-		db, err := sql.Open("mysql", dsn)
-		if err != nil {
-			return nil, err
-		}
-		defer db.Close()
-		rows, err := db.Query(fmt.Sprintf("select %s from %s.%s %s %s", selectItems, c.Config.Schema, c.Config.Table, strings.TrimRight(alias, "."), clause))
-		if err != nil {
-			return nil, err
-		}
+// mysqlType is the column type a table created for a type gets.
+func mysqlType(t datatype.Type) string {
+	switch t {
+	case datatype.INT8:
+		return "TINYINT"
+	case datatype.INT16:
+		return "SMALLINT"
+	case datatype.INT32:
+		return "INT"
+	case datatype.INT64:
+		return "BIGINT"
+	case datatype.UINT8:
+		return "TINYINT UNSIGNED"
+	case datatype.UINT16:
+		return "SMALLINT UNSIGNED"
+	case datatype.UINT32:
+		return "INT UNSIGNED"
+	case datatype.UINT64:
+		return "BIGINT UNSIGNED"
+	case datatype.FLOAT32:
+		return "FLOAT"
+	case datatype.FLOAT64:
+		return "DOUBLE"
+	case datatype.BOOL:
+		return "BOOLEAN"
+	case datatype.DATE:
+		return "DATE"
+	case datatype.TIMESTAMP:
+		return "DATETIME(6)"
+	}
+	return "TEXT"
+}
 
-		record := make([]interface{}, len(md.Columns))
-		for i := range md.Columns {
-			record[i] = new(interface{})
-		}
-		rg := row.NewRowsGroup(md)
-		for rows.Next() {
-			if err := rows.Scan(record...); err != nil {
-				return nil, err
-			}
-			for i, column := range md.Columns {
-				index := md.ColumnMap[column.ColumnName]
-				b := *record[i].(*interface{})
-				rg.Vals[index] = append(rg.Vals[index], datatype.ToValue(b.([]byte), column.ColumnType))
-			}
-			rg.RowsNumber++
+// mysqlCreateTable returns the CREATE TABLE statement of a table with the columns of md.
+func mysqlCreateTable(schema, table string, md *metadata.Metadata, ifNotExists bool) string {
+	var sqlStr strings.Builder
+	sqlStr.WriteString("CREATE TABLE ")
+	if ifNotExists {
+		sqlStr.WriteString("IF NOT EXISTS ")
+	}
+	sqlStr.WriteString(MysqlIdentifier(schema) + "." + MysqlIdentifier(table) + " (")
+	for i, column := range md.Columns {
+		if i > 0 {
+			sqlStr.WriteString(", ")
 		}
-		stop = io.EOF
-		return rg, nil
-	}, nil
+		sqlStr.WriteString(MysqlIdentifier(column.ColumnName) + " " + mysqlType(column.ColumnType))
+	}
+	sqlStr.WriteString(")")
+	return sqlStr.String()
+}
+
+// createMysqlTable creates a table in the database of the config of its name, which takes no
+// properties.
+func createMysqlTable(catalog, schema, table string, md *metadata.Metadata, properties *yaml.Node, ifNotExists bool) error {
+	if properties != nil && len(properties.Content) > 0 {
+		return fmt.Errorf("mysql connector: CREATE TABLE takes no properties")
+	}
+	catalogLock.RLock()
+	conf := mysqlTables.Get().GetConfig(strings.Join([]string{catalog, schema, table}, "."))
+	catalogLock.RUnlock()
+	if conf == nil {
+		return fmt.Errorf("mysql connector: no config for %s.%s.%s", catalog, schema, table)
+	}
+	db, err := (&Mysql{Config: conf}).getDB()
+	if err != nil {
+		return fmt.Errorf("mysql connector: %v", err)
+	}
+	if _, err = db.Exec(mysqlCreateTable(schema, table, md, ifNotExists)); err != nil {
+		return fmt.Errorf("mysql connector: %v", err)
+	}
+	RefreshMysqlMetadata(catalog)
+	return nil
 }
 
 func (c *Mysql) Insert(rb *row.RowsBuffer, columns []string) (affectedRows int64, err error) {
-	if columns == nil || len(columns) == 0 {
+	if len(columns) == 0 {
 		columns = make([]string, c.Metadata.GetColumnNumber())
 		for i, column := range c.Metadata.Columns {
 			columns[i] = column.ColumnName
@@ -142,73 +541,74 @@ func (c *Mysql) Insert(rb *row.RowsBuffer, columns []string) (affectedRows int64
 	}
 
 	indexes := make([]int, len(columns))
-	sqlCache := "INSERT INTO " + c.Config.Schema + "." + c.Config.Table + "( "
+	quoted := make([]string, len(columns))
 	for i, column := range columns {
-		indexes[i], err = c.Metadata.GetIndexByName(column)
-		if err != nil {
+		if indexes[i], err = c.Metadata.GetIndexByName(column); err != nil {
 			return
 		}
-		sqlCache += "`" + column + "`,"
+		quoted[i] = MysqlIdentifier(column)
 	}
 
-	sqlCache = strings.TrimSuffix(sqlCache, ",") + ") VALUES "
-	placeholder := strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",")
-	dsn := c.getDSN()
-	db, err := sql.Open("mysql", dsn)
+	db, err := c.getDB()
 	if err != nil {
 		return
 	}
-	defer db.Close()
-	var rg *row.RowsGroup
-	for {
-		rg, err = rb.Read()
-		if err != nil {
-			if err == io.EOF {
-				err = nil
-			}
-			break
-		}
-		var vals []interface{}
-		sqlStr := sqlCache
-		for r := 0; r < rg.RowsNumber; r++ {
-			for columnNum, index := range indexes {
-				vals = append(vals, datatype.ToValue(rg.Vals[columnNum][r], c.Metadata.Columns[index].ColumnType))
-			}
-
-			sqlStr += "(" + placeholder + "),"
-		}
-		sqlStr = strings.TrimSuffix(sqlStr, ",")
-		stmt, err := db.Prepare(sqlStr)
-		if err != nil {
-			return 0, err
-		}
+	maxBytes, err := mysqlMaxAllowedPacket(db)
+	if err != nil {
+		return
+	}
+	batch := sqlBatch{
+		Prefix: fmt.Sprintf("INSERT INTO %s.%s (%s) VALUES ", MysqlIdentifier(c.Config.Schema),
+			MysqlIdentifier(c.Config.Table), strings.Join(quoted, ", ")),
+		Placeholder: func(_ int) string {
+			return "?"
+		},
+		MaxParameters: MysqlMaxParameters,
+		MaxBytes:      maxBytes,
+		Transaction:   c.Config.Transaction,
+	}
+	return sqlInsert(db, batch, rb, indexes, c.Metadata)
+}
 
-		result, err := stmt.Exec(vals...)
-		if err != nil {
-			return 0, err
-		}
-		insertedRows, err := result.RowsAffected()
-		if err != nil {
-			return affectedRows, err
-		}
+var mysqlPackets sync.Map
 
-		affectedRows += insertedRows
+// mysqlMaxAllowedPacket returns the max_allowed_packet of a server less some headroom for the
+// protocol overhead the size estimate of sqlInsert leaves out.
+func mysqlMaxAllowedPacket(db *sql.DB) (int, error) {
+	if v, ok := mysqlPackets.Load(db); ok {
+		return v.(int), nil
 	}
-
-	return
+	var size int
+	if err := db.QueryRow("SELECT @@max_allowed_packet").Scan(&size); err != nil {
+		return 0, err
+	}
+	size -= size / 16
+	mysqlPackets.Store(db, size)
+	return size, nil
 }
 
 func (c *Mysql) ShowSchemas(catalog string, _, _ *string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key := range config.Conf.MysqlConnectors {
+	schemas := map[string]bool{}
+	for key, conf := range mysqlTables.Get() {
 		ns := strings.Split(key, ".")
-		c, s, _ := ns[0], ns[1], ns[2]
-		if c == catalog {
-			r := row.NewRow()
-			r.AppendVals(s)
-			rs = append(rs, r)
-			break
+		if ns[0] != catalog {
+			continue
+		}
+		names := []string{ns[1]}
+		if strings.ContainsAny(ns[1], "*?") {
+			if names, err = mysqlNames(conf, "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA"); err != nil {
+				break
+			}
+		}
+		for _, s := range names {
+			if config.WildcardMatch(s, ns[1]) && !schemas[s] {
+				schemas[s] = true
+				r := row.NewRow()
+				r.AppendVals(s)
+				rs = append(rs, r)
+			}
 		}
 	}
 	i := 0
@@ -228,13 +628,25 @@ func (c *Mysql) ShowSchemas(catalog string, _, _ *string) row.Reader {
 func (c *Mysql) ShowTables(catalog, schema string, _, _ *string) row.Reader {
 	var err error
 	var rs []*row.Row
-	for key := range config.Conf.MysqlConnectors {
+	tables := map[string]bool{}
+	for key, conf := range mysqlTables.Get() {
 		ns := strings.Split(key, ".")
-		c, s, t := ns[0], ns[1], ns[2]
-		if c == catalog && s == schema {
-			r := row.NewRow()
-			r.AppendVals(t)
-			rs = append(rs, r)
+		if ns[0] != catalog || !config.WildcardMatch(schema, ns[1]) {
+			continue
+		}
+		names := []string{ns[2]}
+		if strings.ContainsAny(ns[2], "*?") {
+			if names, err = mysqlNames(conf, "SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = ?", schema); err != nil {
+				break
+			}
+		}
+		for _, t := range names {
+			if config.WildcardMatch(t, ns[2]) && !tables[t] {
+				tables[t] = true
+				r := row.NewRow()
+				r.AppendVals(t)
+				rs = append(rs, r)
+			}
 		}
 	}
 
@@ -252,18 +664,13 @@ func (c *Mysql) ShowTables(catalog, schema string, _, _ *string) row.Reader {
 }
 
 func (c *Mysql) ShowColumns(catalog, schema, table string) row.Reader {
-	var err error
 	var rs []*row.Row
-	for key, conf := range config.Conf.MysqlConnectors {
-		ns := strings.Split(key, ".")
-		c, s, t := ns[0], ns[1], ns[2]
-		if c == catalog && s == schema && t == table {
-			for i, name := range conf.ColumnNames {
-				r := row.NewRow()
-				r.AppendVals(name, conf.ColumnTypes[i])
-				rs = append(rs, r)
-			}
-			break
+	conn, err := NewMysqlConnector(catalog, schema, table)
+	if err == nil {
+		for i, name := range conn.Config.ColumnNames {
+			r := row.NewRow()
+			r.AppendVals(name, conn.Config.ColumnTypes[i])
+			rs = append(rs, r)
 		}
 	}
 
@@ -281,13 +688,57 @@ func (c *Mysql) ShowColumns(catalog, schema, table string) row.Reader {
 	}
 }
 
+// mysqlNames lists the names a query of INFORMATION_SCHEMA returns from the server of a config.
+func mysqlNames(conf *config.MysqlConnector, query string, args ...interface{}) ([]string, error) {
+	db, err := (&Mysql{Config: conf}).getDB()
+	if err != nil {
+		return nil, fmt.Errorf("mysql connector: %v", err)
+	}
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("mysql connector: %v", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	var name string
+	for rows.Next() {
+		if err = rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("mysql connector: %v", err)
+		}
+		names = append(names, name)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("mysql connector: %v", err)
+	}
+	return names, nil
+}
+
 func (c *Mysql) ShowPartitions(_, _, _ string) row.Reader {
 	return func() (*row.Row, error) {
 		return nil, io.EOF
 	}
 }
 
+// getDB returns the connection pool of the server of the table.
+func (c *Mysql) getDB() (*sql.DB, error) {
+	return sqlPool("mysql", c.getDSN(), c.Config.MaxOpenConns, c.Config.MaxIdleConns,
+		time.Duration(c.Config.ConnMaxLifetime)*time.Second)
+}
+
 func (c *Mysql) getDSN() string {
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/", c.Config.User, c.Config.Password, c.Config.Host, c.Config.Port)
 	return dsn
 }
+
+func init() {
+	Register("mysql", tablesFactory(Factory{
+		New: func(catalog, schema, table string) (Connector, error) {
+			return NewMysqlConnector(catalog, schema, table)
+		},
+		Empty:       func() Connector { return NewMysqlConnectorEmpty() },
+		CreateTable: createMysqlTable,
+	}, &mysqlTables, config.MysqlConnectors.Check, func(c *config.MysqlConnector, catalog string) {
+		c.Catalog = catalog
+	}))
+}
@@ -0,0 +1,182 @@
+package connector
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+)
+
+func TestMysqlMetadataCache(t *testing.T) {
+	mysqlTables.Set(config.MysqlConnectors{
+		"mysql.shop.*": {Host: "127.0.0.1", Port: "1"},
+	})
+	defer func() {
+		mysqlTables.Set(nil)
+		RefreshMysqlMetadata("")
+	}()
+
+	mysqlColumnsMu.Lock()
+	mysqlColumnsCache["mysql.shop.user"] = &mysqlTableColumns{
+		names:   []string{"id", "name"},
+		types:   []string{"INT64", "STRING"},
+		expires: time.Now().Add(time.Minute),
+	}
+	mysqlColumnsMu.Unlock()
+
+	c, err := NewMysqlConnector("mysql", "shop", "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Config.Table != "user" || c.Metadata.GetColumnNumber() != 2 || c.Metadata.Columns[1].ColumnName != "name" {
+		t.Fatalf("unexpected connector %+v", c.Config)
+	}
+
+	RefreshMysqlMetadata("mysql")
+	if _, err = NewMysqlConnector("mysql", "shop", "user"); err == nil {
+		t.Fatal("expected the discovery to fail without a server")
+	}
+}
+
+func TestMysqlSelectQuery(t *testing.T) {
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "mysql", "shop", "user", "name"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "mysql", "shop", "user", "id"))
+	c := &Mysql{Config: &config.MysqlConnector{Schema: "shop", Table: "user"}, Metadata: md}
+	file := partition.NewFileLocation(`{"column":"id","low":10}`, partition.FileTypeUnknown)
+
+	aggregated := md.Copy()
+	aggregated.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "AGG_0"))
+	query, args, err := c.selectQuery(file, aggregated, []*filter.Predicate{
+		filter.NewCompare("id", datatype.GT, int64(3)),
+		filter.NewNot(filter.NewLike("name", "a%", nil)),
+	}, &PushDown{
+		GroupBy:    []string{"id"},
+		Aggregates: []*PushDownAggregate{{Func: "COUNT"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "select MIN(`name`), `id`, COUNT(*) from `shop`.`user` where `id` > ? AND NOT (BINARY `name` LIKE ?) AND `id` >= ? group by `id`"; query != expected || len(args) != 3 {
+		t.Fatalf("unexpected query %s %v", query, args)
+	}
+
+	limit := int64(5)
+	query, _, err = c.selectQuery(file, md, nil, &PushDown{
+		OrderBy: []*PushDownSortItem{{Column: "name", Desc: true}, {Column: "id"}},
+		Limit:   &limit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "select `name`, `id` from `shop`.`user` where `id` >= ? order by BINARY `name` DESC, `id` limit 5"; query != expected {
+		t.Fatalf("unexpected query %s", query)
+	}
+}
+
+func TestMysqlCreateTable(t *testing.T) {
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "mysql", "shop", "orders", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "mysql", "shop", "orders", "note"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.TIMESTAMP, "mysql", "shop", "orders", "created"))
+	sqlStr := mysqlCreateTable("shop", "orders", md, true)
+	if sqlStr != "CREATE TABLE IF NOT EXISTS `shop`.`orders` (`id` BIGINT, `note` TEXT, `created` DATETIME(6))" {
+		t.Errorf("unexpected statement %s", sqlStr)
+	}
+}
+
+func TestMysqlPartition(t *testing.T) {
+	// a sqlite database stands in for the server, it reads the backquoted names of the queries
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "main.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(fmt.Sprintf("ATTACH DATABASE '%s' AS shop; CREATE TABLE shop.user (id INTEGER, name TEXT)", filepath.Join(t.TempDir(), "shop.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 10; i++ {
+		if _, err = db.Exec("INSERT INTO shop.user VALUES (?, ?)", i, fmt.Sprintf("u%02d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = db.Exec("INSERT INTO shop.user VALUES (NULL, NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	conf := &config.MysqlConnector{Schema: "shop", Table: "user", Host: "split", Port: "1"}
+	key := "mysql " + (&Mysql{Config: conf}).getDSN()
+	sqlPoolsMu.Lock()
+	sqlPools[key] = db
+	sqlPoolsMu.Unlock()
+	defer func() {
+		sqlPoolsMu.Lock()
+		delete(sqlPools, key)
+		sqlPoolsMu.Unlock()
+	}()
+
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "mysql", "shop", "user", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "mysql", "shop", "user", "name"))
+	for _, test := range []struct {
+		column    string
+		number    int
+		locations []string
+	}{
+		{"id", 4, []string{`{"column":"id","high":4,"null":true}`, `{"column":"id","low":4,"high":7}`, `{"column":"id","low":7,"high":10}`, `{"column":"id","low":10}`}},
+		{"name", 3, []string{`{"column":"name","high":"u04","null":true}`, `{"column":"name","low":"u04","high":"u07"}`, `{"column":"name","low":"u07"}`}},
+		{"id", 1, []string{`{}`}},
+	} {
+		c := &Mysql{Config: conf, Metadata: md}
+		c.Config.SplitColumn = test.column
+		part, err := c.GetPartition(test.number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(part.Locations, test.locations) {
+			t.Fatalf("unexpected ranges %v", part.Locations)
+		}
+
+		// every row is read by exactly one scan task
+		var ids []int
+		nulls := 0
+		for _, file := range part.GetNoPartitionFiles() {
+			reader, err := c.GetReader(file, md, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for {
+				rg, err := reader(nil)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, id := range rg.Vals[0] {
+					if id == nil {
+						nulls++
+					} else {
+						ids = append(ids, int(id.(int64)))
+					}
+				}
+			}
+		}
+		sort.Ints(ids)
+		if nulls != 1 || fmt.Sprint(ids) != "[1 2 3 4 5 6 7 8 9 10]" {
+			t.Fatalf("split by %s: unexpected ids %v and %d nulls", test.column, ids, nulls)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package connector
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+// PostgresMaxParameters is the most bind parameters a postgres statement takes.
+const PostgresMaxParameters = 65535
+
+type Postgres struct {
+	Config    *config.PostgresConnector
+	Metadata  *metadata.Metadata
+	Partition *partition.Partition
+}
+
+// postgresTables holds the tables of the postgres catalogs.
+var postgresTables config.Tables[config.PostgresConnectors, config.PostgresConnector]
+
+func NewPostgresConnectorEmpty() *Postgres {
+	return &Postgres{}
+}
+
+func NewPostgresConnector(catalog, schema, table string) (*Postgres, error) {
+	var err error
+	res := &Postgres{}
+	key := strings.Join([]string{catalog, schema, table}, ".")
+	conf := postgresTables.Get().GetConfig(key)
+	if conf == nil {
+		return nil, fmt.Errorf("postgres connector: table not found")
+	}
+	temp := *conf
+	temp.Catalog, temp.Schema, temp.Table = catalog, schema, table
+	if len(temp.ColumnNames) == 0 {
+		if temp.ColumnNames, temp.ColumnTypes, err = postgresColumns(&temp); err != nil {
+			return nil, err
+		}
+		if len(temp.ColumnNames) == 0 {
+			return nil, fmt.Errorf("postgres connector: table not found")
+		}
+	}
+	res.Config = &temp
+	res.Metadata, err = NewPostgresMetadata(&temp)
+
+	return res, err
+}
+
+// DefaultPostgresMetadataTTL is how long discovered columns are cached without a metadata-ttl.
+const DefaultPostgresMetadataTTL = 300 * time.Second
+
+type postgresTableColumns struct {
+	names   []string
+	types   []string
+	expires time.Time
+}
+
+var (
+	postgresColumnsMu    sync.Mutex
+	postgresColumnsCache = map[string]*postgresTableColumns{}
+)
+
+// postgresColumns discovers the columns of a table from information_schema and caches them for
+// the metadata-ttl of its config.
+func postgresColumns(conf *config.PostgresConnector) ([]string, []string, error) {
+	key := strings.Join([]string{conf.Catalog, conf.Schema, conf.Table}, ".")
+	postgresColumnsMu.Lock()
+	cached, ok := postgresColumnsCache[key]
+	postgresColumnsMu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.names, cached.types, nil
+	}
+
+	db, err := sqlPool("postgres", conf.DSN(), 0, 0, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("postgres connector: %v", err)
+	}
+	rows, err := db.Query(`SELECT column_name, data_type FROM information_schema.columns
+		WHERE table_schema = $1 AND table_name = $2 ORDER BY ordinal_position`, conf.Schema, conf.Table)
+	if err != nil {
+		return nil, nil, fmt.Errorf("postgres connector: %v", err)
+	}
+	defer rows.Close()
+
+	columns := &postgresTableColumns{names: []string{}, types: []string{}}
+	var name, dataType string
+	for rows.Next() {
+		if err = rows.Scan(&name, &dataType); err != nil {
+			return nil, nil, fmt.Errorf("postgres connector: %v", err)
+		}
+		columns.names = append(columns.names, name)
+		columns.types = append(columns.types, datatype.FromPostgres(dataType).String())
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("postgres connector: %v", err)
+	}
+
+	ttl := DefaultPostgresMetadataTTL
+	if conf.MetadataTTL > 0 {
+		ttl = time.Duration(conf.MetadataTTL) * time.Second
+	}
+	columns.expires = time.Now().Add(ttl)
+	postgresColumnsMu.Lock()
+	postgresColumnsCache[key] = columns
+	postgresColumnsMu.Unlock()
+	return columns.names, columns.types, nil
+}
+
+// RefreshPostgresMetadata drops the cached columns of the tables of a catalog, of all catalogs
+// if it is empty, so that they are discovered again on next use.
+func RefreshPostgresMetadata(catalog string) {
+	postgresColumnsMu.Lock()
+	defer postgresColumnsMu.Unlock()
+	for key := range postgresColumnsCache {
+		if catalog == "" || strings.HasPrefix(key, catalog+".") {
+			delete(postgresColumnsCache, key)
+		}
+	}
+}
+
+func NewPostgresMetadata(conf *config.PostgresConnector) (*metadata.Metadata, error) {
+	res := metadata.NewMetadata()
+	for i := 0; i < len(conf.ColumnNames); i++ {
+		col := &metadata.ColumnMetadata{
+			Catalog:    conf.Catalog,
+			Schema:     conf.Schema,
+			Table:      conf.Table,
+			ColumnName: conf.ColumnNames[i],
+			ColumnType: datatype.FromString(conf.ColumnTypes[i]),
+		}
+		res.AppendColumn(col)
+	}
+
+	res.Reset()
+	return res, nil
+}
+
+func (c *Postgres) GetMetadata() (*metadata.Metadata, error) {
+	return c.Metadata, nil
+}
+
+func (c *Postgres) GetPartition(partitionNumber int) (*partition.Partition, error) {
+	if c.Partition == nil {
+		c.Partition = partition.New(metadata.NewMetadata())
+		c.Partition.Locations = append(c.Partition.Locations, fmt.Sprintf("%d/%d", 0, partitionNumber))
+	}
+	return c.Partition, nil
+}
+
+// PostgresIdentifier double quotes a postgres identifier.
+func PostgresIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+var postgresDialect = &sqlDialect{
+	Identifier: PostgresIdentifier,
+	Placeholder: func(n int) string {
+		return fmt.Sprintf("$%d", n)
+	},
+	// the collation of the database may order strings by the rules of a language
+	Binary: func(column string) string {
+		return column + ` COLLATE "C"`
+	},
+	// a parameter compared with an integer column is taken for an integer
+	Float: func(placeholder string) string {
+		return placeholder + "::float8"
+	},
+	Like: true,
+}
+
+func (c *Postgres) HandledFilters(filters []*filter.Predicate) []bool {
+	return sqlHandledFilters(postgresDialect, filters, c.Metadata)
+}
+
+func (c *Postgres) GetReader(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate) (row.GroupReader, error) {
+	alias := ""
+	if c.Config.Table != md.Columns[0].Table {
+		alias = md.Columns[0].Table
+	}
+
+	selectItems := make([]string, len(md.Columns))
+	for i, column := range md.Columns {
+		selectItems[i] = PostgresIdentifier(column.ColumnName)
+		if alias != "" {
+			selectItems[i] = PostgresIdentifier(alias) + "." + selectItems[i]
+		}
+	}
+
+	qualifier := ""
+	if alias != "" {
+		qualifier = PostgresIdentifier(alias) + "."
+	}
+	var args []interface{}
+	clause := strings.Join(sqlWhere(postgresDialect, filters, c.Metadata, qualifier, &args), " AND ")
+	if clause != "" {
+		clause = " WHERE " + clause
+	}
+
+	from := PostgresIdentifier(c.Config.Schema) + "." + PostgresIdentifier(c.Config.Table)
+	if alias != "" {
+		from += " AS " + PostgresIdentifier(alias)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(selectItems, ", "), from, clause)
+
+	var part, partitionNumber int
+	_, _ = fmt.Sscanf(file.Location, "%d/%d", &part, &partitionNumber)
+	if part > 0 {
+		return func(_ []int) (*row.RowsGroup, error) {
+			return nil, io.EOF
+		}, nil
+	}
+	db, err := sqlPool("postgres", c.Config.DSN(), 0, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return sqlReader(db, md, query, args...), nil
+}
+
+func (c *Postgres) Insert(rb *row.RowsBuffer, columns []string) (affectedRows int64, err error) {
+	if len(columns) == 0 {
+		columns = make([]string, c.Metadata.GetColumnNumber())
+		for i, column := range c.Metadata.Columns {
+			columns[i] = column.ColumnName
+		}
+	}
+
+	indexes := make([]int, len(columns))
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		if indexes[i], err = c.Metadata.GetIndexByName(column); err != nil {
+			return
+		}
+		quoted[i] = PostgresIdentifier(column)
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s.%s (%s) VALUES ", PostgresIdentifier(c.Config.Schema),
+		PostgresIdentifier(c.Config.Table), strings.Join(quoted, ", "))
+	db, err := sqlPool("postgres", c.Config.DSN(), 0, 0, 0)
+	if err != nil {
+		return
+	}
+	batch := sqlBatch{
+		Prefix: prefix,
+		Placeholder: func(n int) string {
+			return fmt.Sprintf("$%d", n)
+		},
+		MaxParameters: PostgresMaxParameters,
+		Transaction:   true,
+	}
+	return sqlInsert(db, batch, rb, indexes, c.Metadata)
+}
+
+func (c *Postgres) ShowSchemas(catalog string, _, _ *string) row.Reader {
+	var err error
+	var rs []*row.Row
+	schemas := map[string]bool{}
+	for key, conf := range postgresTables.Get() {
+		ns := strings.Split(key, ".")
+		if ns[0] != catalog {
+			continue
+		}
+		names := []string{ns[1]}
+		if strings.ContainsAny(ns[1], "*?") {
+			if names, err = postgresNames(conf, `SELECT schema_name FROM information_schema.schemata
+				WHERE schema_name NOT IN ('pg_catalog', 'information_schema')`); err != nil {
+				break
+			}
+		}
+		for _, s := range names {
+			if config.WildcardMatch(s, ns[1]) && !schemas[s] {
+				schemas[s] = true
+				r := row.NewRow()
+				r.AppendVals(s)
+				rs = append(rs, r)
+			}
+		}
+	}
+	i := 0
+
+	return func() (*row.Row, error) {
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(rs) {
+			return nil, io.EOF
+		}
+		i++
+		return rs[i-1], nil
+	}
+}
+
+func (c *Postgres) ShowTables(catalog, schema string, _, _ *string) row.Reader {
+	var err error
+	var rs []*row.Row
+	tables := map[string]bool{}
+	for key, conf := range postgresTables.Get() {
+		ns := strings.Split(key, ".")
+		if ns[0] != catalog || !config.WildcardMatch(schema, ns[1]) {
+			continue
+		}
+		names := []string{ns[2]}
+		if strings.ContainsAny(ns[2], "*?") {
+			if names, err = postgresNames(conf, "SELECT table_name FROM information_schema.tables WHERE table_schema = $1", schema); err != nil {
+				break
+			}
+		}
+		for _, t := range names {
+			if config.WildcardMatch(t, ns[2]) && !tables[t] {
+				tables[t] = true
+				r := row.NewRow()
+				r.AppendVals(t)
+				rs = append(rs, r)
+			}
+		}
+	}
+
+	i := 0
+	return func() (*row.Row, error) {
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(rs) {
+			return nil, io.EOF
+		}
+		i++
+		return rs[i-1], nil
+	}
+}
+
+func (c *Postgres) ShowColumns(catalog, schema, table string) row.Reader {
+	var rs []*row.Row
+	conn, err := NewPostgresConnector(catalog, schema, table)
+	if err == nil {
+		for i, name := range conn.Config.ColumnNames {
+			r := row.NewRow()
+			r.AppendVals(name, conn.Config.ColumnTypes[i])
+			rs = append(rs, r)
+		}
+	}
+
+	i := 0
+	return func() (*row.Row, error) {
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(rs) {
+			return nil, io.EOF
+		}
+
+		i++
+		return rs[i-1], nil
+	}
+}
+
+// postgresNames lists the names a query of information_schema returns from the database of a config.
+func postgresNames(conf *config.PostgresConnector, query string, args ...interface{}) ([]string, error) {
+	db, err := sqlPool("postgres", conf.DSN(), 0, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("postgres connector: %v", err)
+	}
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres connector: %v", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	var name string
+	for rows.Next() {
+		if err = rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("postgres connector: %v", err)
+		}
+		names = append(names, name)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres connector: %v", err)
+	}
+	return names, nil
+}
+
+func (c *Postgres) ShowPartitions(_, _, _ string) row.Reader {
+	return func() (*row.Row, error) {
+		return nil, io.EOF
+	}
+}
+
+func init() {
+	Register("postgres", tablesFactory(Factory{
+		New: func(catalog, schema, table string) (Connector, error) {
+			return NewPostgresConnector(catalog, schema, table)
+		},
+		Empty: func() Connector { return NewPostgresConnectorEmpty() },
+	}, &postgresTables, config.PostgresConnectors.Check, func(c *config.PostgresConnector, catalog string) {
+		c.Catalog = catalog
+	}))
+}
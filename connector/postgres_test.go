@@ -0,0 +1,100 @@
+package connector
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+)
+
+func TestPostgresFilters(t *testing.T) {
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "postgres", "public", "Student", "userId"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "postgres", "public", "Student", "name"))
+	c := &Postgres{Metadata: md}
+
+	escape := "!"
+	filters := []*filter.Predicate{
+		filter.NewAnd(filter.NewCompare("userId", datatype.GT, 1.5), filter.NewCompare("name", datatype.LTE, "userId")),
+		filter.NewOr(filter.NewIn("userId", int64(1), int64(2)), filter.NewNot(filter.NewIsNull("name"))),
+		filter.NewLike("name", `it's 5!% \`, &escape),
+		filter.NewCompare("name", datatype.EQ, int64(1)),
+	}
+	handled := c.HandledFilters(filters)
+	if !handled[0] || !handled[1] || !handled[2] || handled[3] {
+		t.Fatalf("unexpected handled filters %v", handled)
+	}
+
+	var args []interface{}
+	clauses := sqlWhere(postgresDialect, filters, md, `"s".`, &args)
+	expected := []string{
+		`("s"."userId" > $1::float8 AND "s"."name" COLLATE "C" <= $2)`,
+		`("s"."userId" IN ($3, $4) OR NOT ("s"."name" IS NULL))`,
+		`"s"."name" COLLATE "C" LIKE $5`,
+	}
+	if !reflect.DeepEqual(clauses, expected) {
+		t.Fatalf("unexpected clauses %v", clauses)
+	}
+	if !reflect.DeepEqual(args, []interface{}{1.5, "userId", int64(1), int64(2), `it's 5\% \\`}) {
+		t.Fatalf("unexpected args %v", args)
+	}
+}
+
+func TestPostgresValue(t *testing.T) {
+	ts := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+	if v := sqlValue(ts, datatype.DATE); v != (datatype.Date{Sec: ts.Unix()}) {
+		t.Fatalf("unexpected date %v", v)
+	}
+	if v := sqlValue(ts, datatype.TIMESTAMP); v != (datatype.Timestamp{Sec: ts.Unix()}) {
+		t.Fatalf("unexpected timestamp %v", v)
+	}
+	if v := sqlValue([]byte("12.50"), datatype.STRING); v != "12.50" {
+		t.Fatalf("unexpected numeric %v", v)
+	}
+	if v := sqlValue(nil, datatype.INT64); v != nil {
+		t.Fatalf("unexpected null %v", v)
+	}
+	if v := sqlParameter(datatype.Date{Sec: ts.Unix()}, datatype.DATE); v != "2023-04-05" {
+		t.Fatalf("unexpected date parameter %v", v)
+	}
+}
+
+func TestPostgresMetadataCache(t *testing.T) {
+	// the config loads without a server, the columns are discovered on first use
+	tables := config.PostgresConnectors{
+		"pg.public.*": {Host: "127.0.0.1", Port: "1"},
+	}
+	if err := tables.Check(); err != nil {
+		t.Fatal(err)
+	}
+	postgresTables.Set(tables)
+	defer func() {
+		postgresTables.Set(nil)
+		RefreshPostgresMetadata("")
+	}()
+
+	postgresColumnsMu.Lock()
+	postgresColumnsCache["pg.public.orders"] = &postgresTableColumns{
+		names:   []string{"id", "total"},
+		types:   []string{"INT64", "FLOAT64"},
+		expires: time.Now().Add(time.Minute),
+	}
+	postgresColumnsMu.Unlock()
+
+	c, err := NewPostgresConnector("pg", "public", "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Config.Table != "orders" || c.Metadata.GetColumnNumber() != 2 || c.Metadata.Columns[1].ColumnName != "total" {
+		t.Fatalf("unexpected connector %+v", c.Config)
+	}
+
+	RefreshMetadata("pg")
+	if _, err = NewPostgresConnector("pg", "public", "orders"); err == nil {
+		t.Fatal("expected the discovery to fail without a server")
+	}
+}
@@ -0,0 +1,41 @@
+package connector
+
+import (
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+// PushDown is the part of a query above a scan that the optimizer offers to the connector, every
+// scan task computes it over the rows of its own partition.
+type PushDown struct {
+	// GroupBy and Aggregates make a partial aggregation, one row per group of the GroupBy columns
+	GroupBy    []string
+	Aggregates []*PushDownAggregate
+	// OrderBy and Limit make a top-N, or a limit alone without OrderBy
+	OrderBy []*PushDownSortItem
+	Limit   *int64
+}
+
+// PushDownAggregate is COUNT, SUM, MIN or MAX of a column, COUNT of all rows without a column.
+type PushDownAggregate struct {
+	Func   string
+	Column string
+}
+
+// PushDownSortItem sorts by a column the way the engine does, nulls first and strings by their bytes.
+type PushDownSortItem struct {
+	Column string
+	Desc   bool
+}
+
+// PushDowner is implemented by connectors which compute push downs in their readers.
+type PushDowner interface {
+	// CanPushDown reports whether the connector takes the push down
+	CanPushDown(pushDown *PushDown) bool
+	// GetPushDownReader is GetReader with the push down applied after the filters, which must all be
+	// handled ones. With aggregates md lists the table columns and then the aggregates,
+	// the table columns not grouped by may hold the value of any row of the group.
+	GetPushDownReader(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate, pushDown *PushDown) (row.GroupReader, error)
+}
@@ -0,0 +1,173 @@
+package connector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/metadata"
+	"gopkg.in/yaml.v3"
+)
+
+// Factory makes the connectors of a connector type.
+type Factory struct {
+	// New returns the connector of a table of a catalog, Empty one for the SHOW statements
+	New   func(catalog, schema, table string) (Connector, error)
+	Empty func() Connector
+	// Configure adds a catalog of the type with its properties, Drop removes one, Catalogs lists
+	// the catalogs the type knows of. They are optional.
+	Configure func(catalog string, properties *yaml.Node) error
+	Drop      func(catalog string)
+	Catalogs  func() []string
+	// CreateTable and DropTable define the tables of a catalog by statements, they are optional
+	CreateTable func(catalog, schema, table string, md *metadata.Metadata, properties *yaml.Node, ifNotExists bool) error
+	DropTable   func(catalog, schema, table string, ifExists bool) error
+	// Resolved returns the connector of a scan task from what a Resolver encoded, it is optional
+	Resolved func(resolved []byte) (Connector, error)
+}
+
+var factories = map[string]*Factory{}
+
+// Register makes a connector type available to catalogs, registering a type twice panics.
+func Register(typ string, factory Factory) {
+	if _, ok := factories[typ]; ok {
+		panic(fmt.Sprintf("connector type %s registered twice", typ))
+	}
+	factories[typ] = &factory
+}
+
+// Configure adds the catalogs declared in the config to the connectors of their types.
+func Configure() error {
+	catalogLock.Lock()
+	defer catalogLock.Unlock()
+	for name, catalog := range config.Conf.Catalogs {
+		factory, ok := factories[catalog.Type]
+		if !ok {
+			return fmt.Errorf("catalog %s: unknown connector type %s", name, catalog.Type)
+		}
+		if factory.Configure == nil || catalog.Properties.Kind == 0 {
+			continue
+		}
+		if err := factory.Configure(name, &catalog.Properties); err != nil {
+			return fmt.Errorf("catalog %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// catalogType returns the connector type of a catalog, catalogLock must be held. A catalog the config doesn't declare and no
+// type knows of is of the type of its name.
+func catalogType(catalog string) string {
+	if c, ok := config.Conf.Catalogs[catalog]; ok {
+		return c.Type
+	}
+	for typ, factory := range factories {
+		if factory.Catalogs == nil {
+			continue
+		}
+		for _, name := range factory.Catalogs() {
+			if name == catalog {
+				return typ
+			}
+		}
+	}
+	return catalog
+}
+
+// catalogNames returns the catalogs of the config and of all types.
+func catalogNames() []string {
+	catalogLock.RLock()
+	defer catalogLock.RUnlock()
+	names := map[string]struct{}{}
+	for name := range config.Conf.Catalogs {
+		names[name] = struct{}{}
+	}
+	for _, factory := range factories {
+		if factory.Catalogs != nil {
+			for _, name := range factory.Catalogs() {
+				names[name] = struct{}{}
+			}
+		}
+	}
+	res := make([]string, 0, len(names))
+	for name := range names {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// tablesFactory adds to the factory of a built-in type, whose tables are keyed by
+// catalog.schema.table, the catalogs of the tables. The properties of a catalog are its tables
+// keyed by schema.table patterns, or the properties of a single table whose schema and table
+// are the pattern, with the underscores of their names read as dashes.
+func tablesFactory[M ~map[string]*T, T any](factory Factory, tables *config.Tables[M, T], check func(M) error, setCatalog func(*T, string)) Factory {
+	factory.Configure = func(catalog string, properties *yaml.Node) error {
+		properties = tablesProperties(properties)
+		var decoded map[string]*T
+		if err := properties.Decode(&decoded); err != nil {
+			return err
+		}
+		res := M{}
+		for key, table := range tables.Get() {
+			res[key] = table
+		}
+		for pattern, table := range decoded {
+			if table == nil {
+				return fmt.Errorf("table %s has no properties", pattern)
+			}
+			setCatalog(table, catalog)
+			res[catalog+"."+pattern] = table
+		}
+		if err := check(res); err != nil {
+			return err
+		}
+		tables.Set(res)
+		return nil
+	}
+	factory.Drop = func(catalog string) {
+		res := M{}
+		for key, table := range tables.Get() {
+			if !strings.HasPrefix(key, catalog+".") {
+				res[key] = table
+			}
+		}
+		tables.Set(res)
+	}
+	factory.Catalogs = func() []string {
+		var res []string
+		for key := range tables.Get() {
+			res = append(res, strings.Split(key, ".")[0])
+		}
+		return res
+	}
+	return factory
+}
+
+// tablesProperties returns the properties of a catalog keyed by schema.table patterns.
+func tablesProperties(properties *yaml.Node) *yaml.Node {
+	if properties.Kind != yaml.MappingNode {
+		return properties
+	}
+	schema, tableName := "*", "*"
+	table := &yaml.Node{Kind: yaml.MappingNode}
+	for i := 0; i+1 < len(properties.Content); i += 2 {
+		key, value := properties.Content[i], properties.Content[i+1]
+		if value.Kind == yaml.MappingNode {
+			return properties
+		}
+		name := *key
+		name.Value = strings.ReplaceAll(key.Value, "_", "-")
+		switch name.Value {
+		case "schema":
+			schema = value.Value
+		case "table":
+			tableName = value.Value
+		}
+		table.Content = append(table.Content, &name, value)
+	}
+	return &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: schema + "." + tableName}, table,
+	}}
+}
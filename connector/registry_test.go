@@ -0,0 +1,94 @@
+package connector
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRegistry(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"prod", "dev"} {
+		db, err := sql.Open("sqlite3", filepath.Join(dir, name+".db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(fmt.Sprintf("CREATE TABLE env (name TEXT); INSERT INTO env VALUES ('%s')", name))
+		_ = db.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var conf config.Config
+	if err := yaml.Unmarshal([]byte(fmt.Sprintf(`
+catalogs:
+  sqlite_prod:
+    type: sqlite
+    properties:
+      main.env: {schema: main, table: env, path: %[1]s/prod.db}
+  sqlite_dev:
+    type: sqlite
+    properties:
+      main.env: {schema: main, table: env, path: %[1]s/dev.db}
+`, dir)), &conf); err != nil {
+		t.Fatal(err)
+	}
+	config.Conf.Catalogs = conf.Catalogs
+	defer func() {
+		config.Conf.Catalogs = nil
+		sqliteTables.Set(nil)
+	}()
+	if err := config.Conf.Catalogs.Check(); err != nil {
+		t.Fatal(err)
+	}
+	if err := Configure(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"prod", "dev"} {
+		c, err := NewConnector("sqlite_"+name, "main", "env")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part, err := c.GetPartition(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		md, _ := c.GetMetadata()
+		reader, err := c.GetReader(part.GetNoPartitionFiles()[0], md, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rg, err := reader(nil)
+		if err != nil || rg.RowsNumber != 1 || rg.Vals[0][0] != name {
+			t.Fatalf("sqlite_%s: unexpected rows %v, %v", name, rg, err)
+		}
+	}
+
+	like, escape := "sqlite%", ""
+	reader := ShowCatalogs(&like, &escape)
+	var catalogs []interface{}
+	for {
+		r, err := reader()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		catalogs = append(catalogs, r.Vals...)
+	}
+	if fmt.Sprint(catalogs) != "[sqlite_dev sqlite_prod]" {
+		t.Errorf("unexpected catalogs %v", catalogs)
+	}
+
+	config.Conf.Catalogs["other"] = &config.Catalog{Type: "nosql"}
+	if err := Configure(); err == nil {
+		t.Error("expected an error for an unknown connector type")
+	}
+}
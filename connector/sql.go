@@ -0,0 +1,397 @@
+package connector
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gotodb/gotodb/connector/file"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/row"
+)
+
+// sqlValue converts a value scanned by a database/sql driver into a value of the column type.
+func sqlValue(v interface{}, t datatype.Type) interface{} {
+	switch tv := v.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		if t == datatype.DATE {
+			return datatype.Date{Sec: tv.Unix()}
+		}
+		v = datatype.Timestamp{Sec: tv.Unix()}
+	case []byte:
+		v = string(tv)
+	}
+	return datatype.ToValue(v, t)
+}
+
+// sqlParameter converts a value into one the database/sql drivers bind.
+func sqlParameter(v interface{}, t datatype.Type) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch tv := datatype.ToValue(v, t).(type) {
+	case datatype.Date:
+		return time.Unix(tv.Sec, 0).UTC().Format("2006-01-02")
+	case datatype.Timestamp:
+		return time.Unix(tv.Sec, 0).UTC()
+	case uint64:
+		return fmt.Sprintf("%d", tv)
+	default:
+		return tv
+	}
+}
+
+// sqlDialect is how a database spells the filters of sqlFilter.
+type sqlDialect struct {
+	Identifier  func(name string) string
+	Placeholder func(n int) string
+	// Binary makes a string column compare by its bytes, nil if it does already
+	Binary func(column string) string
+	// Float casts a float parameter so that integer columns are compared with it as floats, nil if they are
+	Float func(placeholder string) string
+	// Like is set if LIKE tells upper from lower case as the engine does
+	Like bool
+}
+
+// sqlHandled reports whether the database applies a filter on the columns of md exactly as the engine,
+// which only holds if the literals have the type of their columns.
+func sqlHandled(d *sqlDialect, p *filter.Predicate, md *metadata.Metadata) bool {
+	switch p.Kind {
+	case filter.And, filter.Or, filter.Not:
+		for _, child := range p.Children {
+			if !sqlHandled(d, child, md) {
+				return false
+			}
+		}
+		return true
+	}
+
+	t, err := md.GetTypeByName(p.Column)
+	if err != nil {
+		return false
+	}
+	switch p.Kind {
+	case filter.IsNull:
+		return true
+	case filter.Like:
+		return d.Like && t == datatype.STRING && (len(p.Values) == 1 || len([]rune(p.Values[1].(string))) == 1)
+	}
+	for _, v := range p.Values {
+		switch v.(type) {
+		case int64, float64:
+			if t < datatype.FLOAT64 || t > datatype.UINT64 {
+				return false
+			}
+		case string:
+			if t != datatype.STRING {
+				return false
+			}
+		case bool:
+			if t != datatype.BOOL {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// sqlFilter renders a handled filter, its columns prefixed by the qualifier and its literals
+// appended to args as bind parameters.
+func sqlFilter(d *sqlDialect, p *filter.Predicate, md *metadata.Metadata, qualifier string, args *[]interface{}) string {
+	switch p.Kind {
+	case filter.And, filter.Or:
+		items := make([]string, len(p.Children))
+		for i, child := range p.Children {
+			items[i] = sqlFilter(d, child, md, qualifier, args)
+		}
+		if p.Kind == filter.And {
+			return "(" + strings.Join(items, " AND ") + ")"
+		}
+		return "(" + strings.Join(items, " OR ") + ")"
+	case filter.Not:
+		return "NOT (" + sqlFilter(d, p.Children[0], md, qualifier, args) + ")"
+	case filter.IsNull:
+		return qualifier + d.Identifier(p.Column) + " IS NULL"
+	}
+
+	column := qualifier + d.Identifier(p.Column)
+	if t, _ := md.GetTypeByName(p.Column); t == datatype.STRING && d.Binary != nil {
+		column = d.Binary(column)
+	}
+	parameter := func(v interface{}) string {
+		*args = append(*args, v)
+		placeholder := d.Placeholder(len(*args))
+		if _, ok := v.(float64); ok && d.Float != nil {
+			placeholder = d.Float(placeholder)
+		}
+		return placeholder
+	}
+
+	switch p.Kind {
+	case filter.Compare:
+		return column + " " + filter.OperatorString(p.Operator) + " " + parameter(p.Values[0])
+	case filter.In:
+		items := make([]string, len(p.Values))
+		for i, v := range p.Values {
+			items[i] = parameter(v)
+		}
+		return column + " IN (" + strings.Join(items, ", ") + ")"
+	case filter.Between:
+		return column + " BETWEEN " + parameter(p.Values[0]) + " AND " + parameter(p.Values[1])
+	case filter.Like:
+		var escape string
+		if len(p.Values) > 1 {
+			escape = p.Values[1].(string)
+		}
+		return column + " LIKE " + parameter(sqlLikePattern(p.Values[0].(string), escape))
+	}
+	return ""
+}
+
+// sqlLikePattern respells a LIKE pattern for the default escape of the databases, a backslash,
+// which the engine takes literally if the pattern has no escape.
+func sqlLikePattern(pattern, escape string) string {
+	var b strings.Builder
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case escape != "" && string(runes[i]) == escape && i+1 < len(runes):
+			i++
+			b.WriteRune('\\')
+			b.WriteRune(runes[i])
+		case runes[i] == '\\':
+			b.WriteString(`\\`)
+		default:
+			b.WriteRune(runes[i])
+		}
+	}
+	return b.String()
+}
+
+// sqlWhere returns the clauses of the handled filters, the others are left to the engine.
+func sqlWhere(d *sqlDialect, filters []*filter.Predicate, md *metadata.Metadata, qualifier string, args *[]interface{}) []string {
+	var clauses []string
+	for _, p := range filters {
+		if sqlHandled(d, p, md) {
+			clauses = append(clauses, sqlFilter(d, p, md, qualifier, args))
+		}
+	}
+	return clauses
+}
+
+// sqlHandledFilters is HandledFilters of a database connector.
+func sqlHandledFilters(d *sqlDialect, filters []*filter.Predicate, md *metadata.Metadata) []bool {
+	res := make([]bool, len(filters))
+	for i, p := range filters {
+		res[i] = sqlHandled(d, p, md)
+	}
+	return res
+}
+
+var (
+	sqlPoolsMu sync.Mutex
+	sqlPools   = map[string]*sql.DB{}
+)
+
+// sqlPool returns the connection pool shared by all readers and writers of a database, opening it
+// with the given limits on first use. Zero limits keep the database/sql defaults.
+func sqlPool(driver, dsn string, maxOpen, maxIdle int, maxLifetime time.Duration) (*sql.DB, error) {
+	sqlPoolsMu.Lock()
+	defer sqlPoolsMu.Unlock()
+	key := driver + " " + dsn
+	if db, ok := sqlPools[key]; ok {
+		return db, nil
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if maxOpen > 0 {
+		db.SetMaxOpenConns(maxOpen)
+	}
+	if maxIdle > 0 {
+		db.SetMaxIdleConns(maxIdle)
+	}
+	if maxLifetime > 0 {
+		db.SetConnMaxLifetime(maxLifetime)
+	}
+	sqlPools[key] = db
+	return db, nil
+}
+
+// sqlReader runs a query on the first call and streams its result in batches of
+// file.ReadRowsNumber rows, returning the connection to the pool at the end or on an error.
+func sqlReader(db *sql.DB, md *metadata.Metadata, query string, args ...interface{}) row.GroupReader {
+	var (
+		rows *sql.Rows
+		stop error
+	)
+	finish := func(err error) (*row.RowsGroup, error) {
+		if rows != nil {
+			_ = rows.Close()
+		}
+		stop = err
+		return nil, err
+	}
+	return func(_ []int) (*row.RowsGroup, error) {
+		if stop != nil {
+			return nil, stop
+		}
+
+		var err error
+		if rows == nil {
+			if rows, err = db.Query(query, args...); err != nil {
+				return finish(err)
+			}
+		}
+
+		record := make([]interface{}, len(md.Columns))
+		for i := range md.Columns {
+			record[i] = new(interface{})
+		}
+		rg := row.NewRowsGroup(md)
+		for rg.RowsNumber < file.ReadRowsNumber && rows.Next() {
+			if err = rows.Scan(record...); err != nil {
+				return finish(err)
+			}
+			for i, column := range md.Columns {
+				rg.Vals[i] = append(rg.Vals[i], sqlValue(*record[i].(*interface{}), column.ColumnType))
+			}
+			rg.RowsNumber++
+		}
+		if rg.RowsNumber == 0 {
+			if err = rows.Err(); err == nil {
+				err = io.EOF
+			}
+			return finish(err)
+		}
+		return rg, nil
+	}
+}
+
+// sqlBatch limits the multi row INSERT statements of sqlInsert.
+type sqlBatch struct {
+	// Prefix starts every statement and Placeholder(n) is its nth bind parameter
+	Prefix      string
+	Placeholder func(n int) string
+	// MaxParameters bounds the bind parameters and MaxBytes, if set, the estimated size of a statement
+	MaxParameters int
+	MaxBytes      int
+	Transaction   bool
+}
+
+// sqlSize estimates the bytes a bind parameter takes on the wire.
+func sqlSize(v interface{}) int {
+	switch tv := v.(type) {
+	case string:
+		return len(tv) + 9
+	case []byte:
+		return len(tv) + 9
+	default:
+		return 9
+	}
+}
+
+// sqlInsert writes the rows with as many rows per statement as the batch limits allow,
+// in one transaction if the batch asks for it.
+func sqlInsert(db *sql.DB, batch sqlBatch, rb *row.RowsBuffer, indexes []int, md *metadata.Metadata) (affectedRows int64, err error) {
+	var (
+		tx   *sql.Tx
+		exec = db.Exec
+	)
+	if batch.Transaction {
+		if tx, err = db.Begin(); err != nil {
+			return
+		}
+		exec = tx.Exec
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+				affectedRows = 0
+			}
+		}()
+	}
+
+	var (
+		sqlStr strings.Builder
+		vals   []interface{}
+		size   int
+	)
+	flush := func() error {
+		if len(vals) == 0 {
+			return nil
+		}
+		result, err := exec(sqlStr.String(), vals...)
+		if err != nil {
+			return err
+		}
+		insertedRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		affectedRows += insertedRows
+		sqlStr.Reset()
+		vals, size = vals[:0], 0
+		return nil
+	}
+
+	var rg *row.RowsGroup
+	rowVals := make([]interface{}, len(indexes))
+	placeholderSize := len(batch.Placeholder(batch.MaxParameters)) + 2
+	for {
+		rg, err = rb.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return
+		}
+
+		for r := 0; r < rg.RowsNumber; r++ {
+			rowSize := 4
+			for columnNum, index := range indexes {
+				rowVals[columnNum] = sqlParameter(rg.Vals[columnNum][r], md.Columns[index].ColumnType)
+				rowSize += sqlSize(rowVals[columnNum]) + placeholderSize
+			}
+			if len(vals) > 0 && (len(vals)+len(indexes) > batch.MaxParameters ||
+				batch.MaxBytes > 0 && size+rowSize > batch.MaxBytes) {
+				if err = flush(); err != nil {
+					return
+				}
+			}
+
+			if len(vals) == 0 {
+				sqlStr.WriteString(batch.Prefix)
+				size = len(batch.Prefix)
+			} else {
+				sqlStr.WriteString(", ")
+			}
+			sqlStr.WriteString("(")
+			for columnNum := range indexes {
+				if columnNum > 0 {
+					sqlStr.WriteString(", ")
+				}
+				vals = append(vals, rowVals[columnNum])
+				sqlStr.WriteString(batch.Placeholder(len(vals)))
+			}
+			sqlStr.WriteString(")")
+			size += rowSize
+		}
+	}
+	if err = flush(); err != nil {
+		return
+	}
+
+	if tx != nil {
+		err = tx.Commit()
+	}
+	return
+}
@@ -0,0 +1,73 @@
+package connector
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/row"
+)
+
+func TestSQLInsertBatches(t *testing.T) {
+	db, err := sqlPool("sqlite3", filepath.Join(t.TempDir(), "batch.db"), 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("CREATE TABLE t (id INTEGER NOT NULL, name TEXT)"); err != nil {
+		t.Fatal(err)
+	}
+
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "sqlite", "main", "t", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "sqlite", "main", "t", "name"))
+	rows := func(ids ...interface{}) *row.RowsBuffer {
+		in := row.NewRowsGroup(md)
+		for _, id := range ids {
+			in.AppendRowVals(id, "name")
+		}
+		var buf bytes.Buffer
+		rb := row.NewRowsBuffer(md, &buf, &buf)
+		if err := rb.Write(in); err != nil {
+			t.Fatal(err)
+		}
+		if err := rb.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		return rb
+	}
+	count := func() (n int) {
+		if err := db.QueryRow("SELECT COUNT(*) FROM t").Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	var statements int
+	batch := sqlBatch{
+		Prefix: "INSERT INTO t (id, name) VALUES ",
+		Placeholder: func(n int) string {
+			if n == 1 {
+				statements++
+			}
+			return "?"
+		},
+		MaxParameters: 4,
+		Transaction:   true,
+	}
+	if n, err := sqlInsert(db, batch, rows(int64(1), int64(2), int64(3), int64(4), int64(5)), []int{0, 1}, md); err != nil || n != 5 {
+		t.Fatalf("unexpected insert result %d, %v", n, err)
+	}
+	if statements != 3 || count() != 5 {
+		t.Fatalf("expected 5 rows in 3 statements, got %d in %d", count(), statements)
+	}
+
+	batch.MaxParameters, batch.MaxBytes = 100, 40
+	if n, err := sqlInsert(db, batch, rows(int64(6), int64(7), nil), []int{0, 1}, md); err == nil || n != 0 {
+		t.Fatalf("expected a failed insert, got %d, %v", n, err)
+	}
+	if count() != 5 {
+		t.Fatalf("the failed insert left %d rows", count()-5)
+	}
+}
@@ -0,0 +1,263 @@
+package connector
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/row"
+)
+
+// SqliteMaxParameters is the most bind parameters a sqlite statement takes.
+const SqliteMaxParameters = 32766
+
+type Sqlite struct {
+	Config    *config.SqliteConnector
+	Metadata  *metadata.Metadata
+	Partition *partition.Partition
+}
+
+// sqliteTables holds the tables of the sqlite catalogs.
+var sqliteTables config.Tables[config.SqliteConnectors, config.SqliteConnector]
+
+func NewSqliteConnectorEmpty() *Sqlite {
+	return &Sqlite{}
+}
+
+func NewSqliteConnector(catalog, schema, table string) (*Sqlite, error) {
+	var err error
+	res := &Sqlite{}
+	key := strings.Join([]string{catalog, schema, table}, ".")
+	conf := sqliteTables.Get().GetConfig(key)
+	if conf == nil {
+		return nil, fmt.Errorf("sqlite connector: table not found")
+	}
+	res.Config = conf
+	res.Metadata, err = NewSqliteMetadata(conf)
+
+	return res, err
+}
+
+func NewSqliteMetadata(conf *config.SqliteConnector) (*metadata.Metadata, error) {
+	res := metadata.NewMetadata()
+	for i := 0; i < len(conf.ColumnNames); i++ {
+		col := &metadata.ColumnMetadata{
+			Catalog:    conf.Catalog,
+			Schema:     conf.Schema,
+			Table:      conf.Table,
+			ColumnName: conf.ColumnNames[i],
+			ColumnType: datatype.FromString(conf.ColumnTypes[i]),
+		}
+		res.AppendColumn(col)
+	}
+
+	res.Reset()
+	return res, nil
+}
+
+func (c *Sqlite) GetMetadata() (*metadata.Metadata, error) {
+	return c.Metadata, nil
+}
+
+func (c *Sqlite) GetPartition(partitionNumber int) (*partition.Partition, error) {
+	if c.Partition == nil {
+		c.Partition = partition.New(metadata.NewMetadata())
+		c.Partition.Locations = append(c.Partition.Locations, fmt.Sprintf("%d/%d", 0, partitionNumber))
+	}
+	return c.Partition, nil
+}
+
+// SqliteIdentifier double quotes a sqlite identifier.
+func SqliteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+var sqliteDialect = &sqlDialect{
+	Identifier: SqliteIdentifier,
+	Placeholder: func(_ int) string {
+		return "?"
+	},
+	// LIKE ignores the case of ASCII letters
+	Like: false,
+}
+
+func (c *Sqlite) HandledFilters(filters []*filter.Predicate) []bool {
+	return sqlHandledFilters(sqliteDialect, filters, c.Metadata)
+}
+
+func (c *Sqlite) GetReader(file *partition.FileLocation, md *metadata.Metadata, filters []*filter.Predicate) (row.GroupReader, error) {
+	alias := ""
+	if c.Config.Table != md.Columns[0].Table {
+		alias = md.Columns[0].Table
+	}
+
+	selectItems := make([]string, len(md.Columns))
+	for i, column := range md.Columns {
+		selectItems[i] = SqliteIdentifier(column.ColumnName)
+		if alias != "" {
+			selectItems[i] = SqliteIdentifier(alias) + "." + selectItems[i]
+		}
+	}
+
+	qualifier := ""
+	if alias != "" {
+		qualifier = SqliteIdentifier(alias) + "."
+	}
+	var args []interface{}
+	clause := strings.Join(sqlWhere(sqliteDialect, filters, c.Metadata, qualifier, &args), " AND ")
+	if clause != "" {
+		clause = " WHERE " + clause
+	}
+
+	from := SqliteIdentifier(c.Config.Table)
+	if alias != "" {
+		from += " AS " + SqliteIdentifier(alias)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(selectItems, ", "), from, clause)
+
+	var part, partitionNumber int
+	_, _ = fmt.Sscanf(file.Location, "%d/%d", &part, &partitionNumber)
+	if part > 0 {
+		return func(_ []int) (*row.RowsGroup, error) {
+			return nil, io.EOF
+		}, nil
+	}
+	db, err := sqlPool("sqlite3", c.Config.Path, 0, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return sqlReader(db, md, query, args...), nil
+}
+
+func (c *Sqlite) Insert(rb *row.RowsBuffer, columns []string) (affectedRows int64, err error) {
+	if len(columns) == 0 {
+		columns = make([]string, c.Metadata.GetColumnNumber())
+		for i, column := range c.Metadata.Columns {
+			columns[i] = column.ColumnName
+		}
+	}
+
+	indexes := make([]int, len(columns))
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		if indexes[i], err = c.Metadata.GetIndexByName(column); err != nil {
+			return
+		}
+		quoted[i] = SqliteIdentifier(column)
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", SqliteIdentifier(c.Config.Table), strings.Join(quoted, ", "))
+	db, err := sqlPool("sqlite3", c.Config.Path, 0, 0, 0)
+	if err != nil {
+		return
+	}
+	batch := sqlBatch{
+		Prefix: prefix,
+		Placeholder: func(_ int) string {
+			return "?"
+		},
+		MaxParameters: SqliteMaxParameters,
+		Transaction:   true,
+	}
+	return sqlInsert(db, batch, rb, indexes, c.Metadata)
+}
+
+func (c *Sqlite) ShowSchemas(catalog string, _, _ *string) row.Reader {
+	var err error
+	var rs []*row.Row
+	schemas := map[string]bool{}
+	for key := range sqliteTables.Get() {
+		ns := strings.Split(key, ".")
+		c, s, _ := ns[0], ns[1], ns[2]
+		if c == catalog && !schemas[s] {
+			schemas[s] = true
+			r := row.NewRow()
+			r.AppendVals(s)
+			rs = append(rs, r)
+		}
+	}
+	i := 0
+
+	return func() (*row.Row, error) {
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(rs) {
+			return nil, io.EOF
+		}
+		i++
+		return rs[i-1], nil
+	}
+}
+
+func (c *Sqlite) ShowTables(catalog, schema string, _, _ *string) row.Reader {
+	var err error
+	var rs []*row.Row
+	for key := range sqliteTables.Get() {
+		ns := strings.Split(key, ".")
+		c, s, t := ns[0], ns[1], ns[2]
+		if c == catalog && s == schema && !strings.ContainsAny(t, "*?") {
+			r := row.NewRow()
+			r.AppendVals(t)
+			rs = append(rs, r)
+		}
+	}
+
+	i := 0
+	return func() (*row.Row, error) {
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(rs) {
+			return nil, io.EOF
+		}
+		i++
+		return rs[i-1], nil
+	}
+}
+
+func (c *Sqlite) ShowColumns(catalog, schema, table string) row.Reader {
+	var err error
+	var rs []*row.Row
+	if conf := sqliteTables.Get().GetConfig(strings.Join([]string{catalog, schema, table}, ".")); conf != nil {
+		for i, name := range conf.ColumnNames {
+			r := row.NewRow()
+			r.AppendVals(name, conf.ColumnTypes[i])
+			rs = append(rs, r)
+		}
+	}
+
+	i := 0
+	return func() (*row.Row, error) {
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(rs) {
+			return nil, io.EOF
+		}
+
+		i++
+		return rs[i-1], nil
+	}
+}
+
+func (c *Sqlite) ShowPartitions(_, _, _ string) row.Reader {
+	return func() (*row.Row, error) {
+		return nil, io.EOF
+	}
+}
+
+func init() {
+	Register("sqlite", tablesFactory(Factory{
+		New: func(catalog, schema, table string) (Connector, error) {
+			return NewSqliteConnector(catalog, schema, table)
+		},
+		Empty: func() Connector { return NewSqliteConnectorEmpty() },
+	}, &sqliteTables, config.SqliteConnectors.Check, func(c *config.SqliteConnector, catalog string) {
+		c.Catalog = catalog
+	}))
+}
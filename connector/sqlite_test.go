@@ -0,0 +1,96 @@
+package connector
+
+import (
+	"bytes"
+	"database/sql"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/row"
+)
+
+func TestSqlite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ref.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`CREATE TABLE country (Code VARCHAR(2), population BIGINT, area REAL, joined DATE, active BOOLEAN);
+		INSERT INTO country VALUES ('de', 83, 357.6, '1958-01-01', 1), ('fr', 68, 551.7, '1958-01-01', 1), ('no', 5, NULL, NULL, 0)`)
+	_ = db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := config.SqliteConnectors{
+		"sqlite.ref.*": {Catalog: "sqlite", Schema: "ref", Table: "*", Path: path},
+	}
+	if err = tables.Check(); err != nil {
+		t.Fatal(err)
+	}
+	sqliteTables.Set(tables)
+	defer func() {
+		sqliteTables.Set(nil)
+	}()
+
+	c, err := NewSqliteConnector("sqlite", "ref", "country")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := []datatype.Type{datatype.STRING, datatype.INT64, datatype.FLOAT64, datatype.DATE, datatype.BOOL}
+	for i, column := range c.Metadata.Columns {
+		if column.ColumnType != types[i] {
+			t.Fatalf("unexpected type of %s: %v", column.ColumnName, column.ColumnType)
+		}
+	}
+
+	read := func(filters []*filter.Predicate) *row.RowsGroup {
+		part, err := c.GetPartition(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader, err := c.GetReader(part.GetNoPartitionFiles()[0], c.Metadata, filters)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rg, err := reader(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = reader(nil); err != io.EOF {
+			t.Fatalf("expected EOF, got %v", err)
+		}
+		return rg
+	}
+
+	rg := read([]*filter.Predicate{
+		filter.NewCompare("Code", datatype.NEQ, "fr"),
+		filter.NewCompare("population", datatype.GT, int64(10)),
+	})
+	if rg.RowsNumber != 1 || rg.Vals[0][0] != "de" || rg.Vals[3][0] != (datatype.Date{Sec: -378691200}) || rg.Vals[4][0] != true {
+		t.Fatalf("unexpected rows %v", rg.Vals)
+	}
+
+	in := row.NewRowsGroup(c.Metadata.SelectColumnsByIndexes([]int{0, 1}))
+	in.AppendRowVals("is", int64(1))
+	var buf bytes.Buffer
+	rb := row.NewRowsBuffer(in.Metadata, &buf, &buf)
+	if err = rb.Write(in); err != nil {
+		t.Fatal(err)
+	}
+	if err = rb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := c.Insert(rb, []string{"Code", "population"}); err != nil || n != 1 {
+		t.Fatalf("unexpected insert result %d, %v", n, err)
+	}
+
+	rg = read([]*filter.Predicate{filter.NewCompare("population", datatype.LT, int64(10))})
+	if rg.RowsNumber != 2 || rg.Vals[0][1] != "is" || rg.Vals[2][1] != nil {
+		t.Fatalf("unexpected rows %v", rg.Vals)
+	}
+}
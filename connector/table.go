@@ -0,0 +1,235 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector/file"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/partition"
+	"github.com/gotodb/gotodb/store"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// tablePrefix prefixes the store keys of the file tables created by CREATE TABLE.
+const tablePrefix = "table/"
+
+// CreateTable defines a table of a catalog with the columns of md, the properties are those of
+// the connector type.
+func CreateTable(catalog, schema, table string, md *metadata.Metadata, properties *yaml.Node, ifNotExists bool) error {
+	catalogLock.RLock()
+	factory, ok := factories[catalogType(catalog)]
+	catalogLock.RUnlock()
+	if !ok || factory.CreateTable == nil {
+		return fmt.Errorf("catalog %s doesn't support CREATE TABLE", catalog)
+	}
+	return factory.CreateTable(catalog, schema, table, md, properties, ifNotExists)
+}
+
+// DropTable removes a table defined by CreateTable.
+func DropTable(catalog, schema, table string, ifExists bool) error {
+	catalogLock.RLock()
+	factory, ok := factories[catalogType(catalog)]
+	catalogLock.RUnlock()
+	if !ok || factory.DropTable == nil {
+		return fmt.Errorf("catalog %s doesn't support DROP TABLE", catalog)
+	}
+	return factory.DropTable(catalog, schema, table, ifExists)
+}
+
+// WatchTables adds the file tables of the store and follows their changes until ctx is done.
+func WatchTables(ctx context.Context) error {
+	return store.Default.Watch(ctx, tablePrefix, func(key string, value []byte) {
+		name := strings.TrimPrefix(key, tablePrefix)
+		if value == nil {
+			applyFileTable(name, nil)
+			return
+		}
+		var conf config.FileConnector
+		if err := yaml.Unmarshal(value, &conf); err != nil {
+			logrus.Errorf("table %s: %v", name, err)
+			return
+		}
+		applyFileTable(name, &conf)
+	})
+}
+
+// createFileTable stores the config of a file table. The properties are format, csv by default,
+// location, the directory of the files, partitioned_by, the columns of the key=value directories
+// in the order they nest, and the other settings of a file table, underscores read as dashes.
+func createFileTable(catalog, schema, table string, md *metadata.Metadata, properties *yaml.Node, ifNotExists bool) error {
+	name := strings.Join([]string{catalog, schema, table}, ".")
+	_, exists := file.Tables.Get()[name]
+	if exists {
+		if ifNotExists {
+			return nil
+		}
+		return fmt.Errorf("table %s already exists", name)
+	}
+
+	conf, err := fileTableConfig(catalog, schema, table, md, properties)
+	if err != nil {
+		return err
+	}
+	if err = (config.FileConnectors{name: conf}).Check(); err != nil {
+		return err
+	}
+	if err = os.MkdirAll(conf.Paths[0], 0755); err != nil {
+		return err
+	}
+	value, err := yaml.Marshal(conf)
+	if err != nil {
+		return err
+	}
+	created, err := store.Default.Create(tablePrefix+name, value)
+	if err != nil {
+		return err
+	}
+	if !created {
+		if ifNotExists {
+			return nil
+		}
+		return fmt.Errorf("table %s already exists", name)
+	}
+	applyFileTable(name, conf)
+	return nil
+}
+
+// dropFileTable removes the config of a file table, its files are left as they are.
+func dropFileTable(catalog, schema, table string, ifExists bool) error {
+	name := strings.Join([]string{catalog, schema, table}, ".")
+	deleted, err := store.Default.Delete(tablePrefix + name)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		applyFileTable(name, nil)
+		return nil
+	}
+	_, exists := file.Tables.Get()[name]
+	if exists {
+		return fmt.Errorf("table %s is not created by CREATE TABLE", name)
+	}
+	if ifExists {
+		return nil
+	}
+	return fmt.Errorf("table %s does not exist", name)
+}
+
+func fileTableConfig(catalog, schema, table string, md *metadata.Metadata, properties *yaml.Node) (*config.FileConnector, error) {
+	format, location := "csv", ""
+	var partitionedBy []string
+	settings := &yaml.Node{Kind: yaml.MappingNode}
+	if properties != nil && properties.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(properties.Content); i += 2 {
+			key, value := properties.Content[i], properties.Content[i+1]
+			var err error
+			switch key.Value {
+			case "format":
+				err = value.Decode(&format)
+			case "location":
+				err = value.Decode(&location)
+			case "partitioned_by":
+				err = value.Decode(&partitionedBy)
+			default:
+				name := *key
+				name.Value = strings.ReplaceAll(key.Value, "_", "-")
+				settings.Content = append(settings.Content, &name, value)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %v", key.Value, err)
+			}
+		}
+	}
+
+	conf := &config.FileConnector{}
+	if err := settings.Decode(conf); err != nil {
+		return nil, err
+	}
+	if partition.StringToFileType(format) == partition.FileTypeUnknown {
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+	if location == "" {
+		return nil, fmt.Errorf("location must be set")
+	}
+	conf.Catalog, conf.Schema, conf.Table = catalog, schema, table
+	conf.FileType, conf.Paths = format, []string{location}
+
+	partitions := map[string]bool{}
+	for _, column := range partitionedBy {
+		partitions[column] = true
+	}
+	conf.ColumnNames, conf.ColumnTypes = nil, nil
+	for _, column := range md.Columns {
+		if !partitions[column.ColumnName] {
+			conf.ColumnNames = append(conf.ColumnNames, column.ColumnName)
+			conf.ColumnTypes = append(conf.ColumnTypes, column.ColumnType.String())
+		}
+	}
+	for _, name := range partitionedBy {
+		index, err := md.GetIndexByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("partition column %s is not a column of the table", name)
+		}
+		conf.PartitionNames = append(conf.PartitionNames, name)
+		conf.PartitionTypes = append(conf.PartitionTypes, md.Columns[index].ColumnType.String())
+	}
+	return conf, nil
+}
+
+// storedFileTable returns the config of a file table in the store, nil if there is none.
+func storedFileTable(catalog, schema, table string) *config.FileConnector {
+	value, err := store.Default.Get(tablePrefix + strings.Join([]string{catalog, schema, table}, "."))
+	if err != nil || value == nil {
+		return nil
+	}
+	var conf config.FileConnector
+	if err = yaml.Unmarshal(value, &conf); err != nil {
+		return nil
+	}
+	return &conf
+}
+
+// applyFileTable adds, replaces or, if conf is nil, removes the config of a file table. It holds
+// catalogLock, as the catalogs of the file type change the tables too.
+func applyFileTable(name string, conf *config.FileConnector) {
+	catalogLock.Lock()
+	defer catalogLock.Unlock()
+	tables := config.FileConnectors{}
+	for key, c := range file.Tables.Get() {
+		if key != name {
+			tables[key] = c
+		}
+	}
+	if conf != nil {
+		tables[name] = conf
+	}
+	file.Tables.Set(tables)
+}
+
+func init() {
+	Register("file", tablesFactory(Factory{
+		New: func(catalog, schema, table string) (Connector, error) {
+			res, err := file.NewFileConnector(catalog, schema, table)
+			if err != nil {
+				// a table created a moment ago may not have been watched yet
+				if conf := storedFileTable(catalog, schema, table); conf != nil {
+					return file.NewFileConnectorFromConfig(conf)
+				}
+			}
+			return res, err
+		},
+		Empty:       func() Connector { return file.NewFileConnectorEmpty() },
+		CreateTable: createFileTable,
+		DropTable:   dropFileTable,
+		Resolved: func(resolved []byte) (Connector, error) {
+			return file.NewResolvedFileConnector(resolved)
+		},
+	}, &file.Tables, config.FileConnectors.Check, func(c *config.FileConnector, catalog string) {
+		c.Catalog = catalog
+	}))
+}
@@ -0,0 +1,89 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotodb/gotodb/connector/file"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/store"
+	"gopkg.in/yaml.v3"
+)
+
+func TestFileTables(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "events")
+	store.Default = store.NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		file.Tables.Set(nil)
+	}()
+	if err := WatchTables(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "file", "logs", "events", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "logs", "events", "dt"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "file", "logs", "events", "name"))
+	var properties yaml.Node
+	if err := yaml.Unmarshal([]byte("{location: "+dir+", partitioned_by: [dt], skip_header: true}"), &properties); err != nil {
+		t.Fatal(err)
+	}
+	if err := CreateTable("file", "logs", "events", md, properties.Content[0], false); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "dt=2024-01-01"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "dt=2024-01-01", "0.csv"), []byte("id,name\n1,a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewConnector("file", "logs", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableMD, _ := c.GetMetadata()
+	var names []string
+	for _, column := range tableMD.Columns {
+		names = append(names, column.ColumnName)
+	}
+	if fmt.Sprint(names) != "[id name dt]" || tableMD.Columns[2].ColumnType != datatype.STRING {
+		t.Fatalf("unexpected columns %v", names)
+	}
+	part, err := c.GetPartition(1)
+	if err != nil || part.GetPartitionNum() != 1 {
+		t.Fatalf("unexpected partitions %v, %v", part, err)
+	}
+
+	if err = CreateTable("file", "logs", "events", md, properties.Content[0], false); err == nil {
+		t.Error("expected an error for an existing table")
+	}
+	if err = CreateTable("file", "logs", "events", md, properties.Content[0], true); err != nil {
+		t.Error(err)
+	}
+	if err = CreateTable("test", "logs", "events", md, nil, false); err == nil {
+		t.Error("expected an error for a catalog without CREATE TABLE")
+	}
+
+	if err = DropTable("file", "logs", "events", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewConnector("file", "logs", "events"); err == nil {
+		t.Error("expected an error for a dropped table")
+	}
+	if _, err = os.Stat(filepath.Join(dir, "dt=2024-01-01", "0.csv")); err != nil {
+		t.Error("expected the files of a dropped table to be kept")
+	}
+	if err = DropTable("file", "logs", "events", false); err == nil {
+		t.Error("expected an error for a missing table")
+	}
+	if err = DropTable("file", "logs", "events", true); err != nil {
+		t.Error(err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/gotodb/gotodb/connector/file"
 	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/partition"
 	"github.com/gotodb/gotodb/row"
@@ -163,8 +164,12 @@ func (c *Test) GetPartition(_ int) (*partition.Partition, error) {
 	return c.Partition, nil
 }
 
-func (c *Test) GetReader(f *partition.FileLocation, selectedMD *metadata.Metadata, _ []string) (row.GroupReader, error) {
-	reader, err := file.NewHandler(f, c.Metadata, true)
+func (c *Test) HandledFilters(filters []*filter.Predicate) []bool {
+	return make([]bool, len(filters))
+}
+
+func (c *Test) GetReader(f *partition.FileLocation, selectedMD *metadata.Metadata, _ []*filter.Predicate) (row.GroupReader, error) {
+	reader, err := file.NewHandler(f, c.Metadata, nil, true, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +191,7 @@ func (c *Test) Insert(rb *row.RowsBuffer, Columns []string) (affectedRows int64,
 	}
 	rand.Seed(time.Now().Unix())
 	n := rand.Intn(len(c.Partition.Locations))
-	writer, err := file.NewHandler(part.GetNoPartitionFiles()[n], c.Metadata, false)
+	writer, err := file.NewHandler(part.GetNoPartitionFiles()[n], c.Metadata, nil, false, nil)
 	if err != nil {
 		return
 	}
@@ -274,3 +279,12 @@ func (c *Test) ShowPartitions(_, _, _ string) row.Reader {
 		return nil, io.EOF
 	}
 }
+
+func init() {
+	Register("test", Factory{
+		New: func(catalog, schema, table string) (Connector, error) {
+			return NewTestConnector(catalog, schema, table)
+		},
+		Empty: func() Connector { return NewTestConnectorEmpty() },
+	})
+}
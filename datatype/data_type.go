@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -116,6 +117,77 @@ func FromMysql(name string, unsigned bool) Type {
 	}
 }
 
+// FromPostgres maps the data_type of information_schema.columns, numeric and any type
+// without a counterpart are read as their text.
+func FromPostgres(name string) Type {
+	switch name {
+	case "smallint":
+		return INT16
+	case "integer":
+		return INT32
+	case "bigint":
+		return INT64
+	case "real":
+		return FLOAT32
+	case "double precision":
+		return FLOAT64
+	case "boolean":
+		return BOOL
+	case "date":
+		return DATE
+	case "timestamp without time zone", "timestamp with time zone":
+		return TIMESTAMP
+	default:
+		return STRING
+	}
+}
+
+// FromSql maps the type of a column definition, the sql names and the names of String alike.
+func FromSql(name string) Type {
+	switch name = strings.ToUpper(name); name {
+	case "TINYINT":
+		return INT8
+	case "SMALLINT":
+		return INT16
+	case "INT", "INTEGER":
+		return INT32
+	case "BIGINT":
+		return INT64
+	case "REAL", "FLOAT":
+		return FLOAT32
+	case "DOUBLE", "DOUBLE PRECISION", "DECIMAL", "NUMERIC":
+		return FLOAT64
+	case "VARCHAR", "CHAR", "TEXT":
+		return STRING
+	case "BOOLEAN":
+		return BOOL
+	}
+	return FromString(name)
+}
+
+// FromSqlite maps a declared column type by the sqlite type affinity rules, telling booleans,
+// dates and timestamps apart from the other numeric columns.
+func FromSqlite(name string) Type {
+	name = strings.ToUpper(name)
+	switch {
+	case strings.Contains(name, "INT"):
+		return INT64
+	case strings.Contains(name, "CHAR"), strings.Contains(name, "CLOB"), strings.Contains(name, "TEXT"),
+		strings.Contains(name, "BLOB"), name == "":
+		return STRING
+	case strings.Contains(name, "REAL"), strings.Contains(name, "FLOA"), strings.Contains(name, "DOUB"):
+		return FLOAT64
+	case strings.Contains(name, "BOOL"):
+		return BOOL
+	case strings.Contains(name, "TIME"):
+		return TIMESTAMP
+	case strings.Contains(name, "DATE"):
+		return DATE
+	default:
+		return FLOAT64
+	}
+}
+
 func FromString(name string) Type {
 	switch name {
 	case "STRING":
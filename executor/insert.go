@@ -39,7 +39,12 @@ func (e *Executor) RunInsert() error {
 	}
 
 	rbReader := row.NewRowsBuffer(md, reader, nil)
-	affectedRows, err := ctr.Insert(rbReader, job.Columns)
+	var affectedRows int64
+	if stager, ok := ctr.(connector.StagedInserter); ok {
+		affectedRows, err = stager.StageInsert(rbReader, job.Columns, job.Token, job.Task)
+	} else {
+		affectedRows, err = ctr.Insert(rbReader, job.Columns)
+	}
 	if err != nil {
 		return err
 	}
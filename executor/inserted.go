@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/gotodb/gotodb/connector"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/pb"
 	"github.com/gotodb/gotodb/row"
@@ -19,10 +20,27 @@ func (e *Executor) SetInstructionInserted(instruction *pb.Instruction) (err erro
 	return nil
 }
 
-func (e *Executor) RunInserted() error {
+func (e *Executor) RunInserted() (err error) {
+	job := e.StageJob.(*stage.InsertedJob)
+	var stager connector.StagedInserter
+	if job.Catalog != "" {
+		ctr, err := connector.NewConnector(job.Catalog, job.Schema, job.Table)
+		if err != nil {
+			return err
+		}
+		stager, _ = ctr.(connector.StagedInserter)
+	}
+	if stager != nil {
+		defer func() {
+			if err != nil {
+				_ = stager.AbortInsert(job.Token)
+			}
+		}()
+	}
+
 	md := &metadata.Metadata{}
 	for _, reader := range e.Readers {
-		if err := util.ReadObject(reader, md); err != nil {
+		if err = util.ReadObject(reader, md); err != nil {
 			return err
 		}
 	}
@@ -34,7 +52,6 @@ func (e *Executor) RunInserted() error {
 		for {
 			rg, err := rbReader.Read()
 			if err == io.EOF {
-				err = nil
 				break
 			}
 			if err != nil {
@@ -47,6 +64,12 @@ func (e *Executor) RunInserted() error {
 		}
 	}
 
+	if stager != nil {
+		if err = stager.CommitInsert(job.Token); err != nil {
+			return err
+		}
+	}
+
 	writer := e.Writers[0]
 	if err := util.WriteObject(writer, md); err != nil {
 		return err
@@ -1,8 +1,11 @@
 package executor
 
 import (
+	"fmt"
 	"github.com/gotodb/gotodb/connector"
+	"github.com/gotodb/gotodb/filter"
 	"github.com/gotodb/gotodb/pb"
+	"github.com/gotodb/gotodb/planner"
 	"github.com/gotodb/gotodb/row"
 	"github.com/gotodb/gotodb/stage"
 	"github.com/gotodb/gotodb/util"
@@ -30,7 +33,7 @@ func (e *Executor) RunScan() error {
 
 	job := e.StageJob.(*stage.ScanJob)
 
-	ctr, err := connector.NewConnector(job.Catalog, job.Schema, job.Table)
+	ctr, err := connector.NewResolvedConnector(job.Catalog, job.Schema, job.Table, job.Resolved)
 	if err != nil {
 		return err
 	}
@@ -45,13 +48,53 @@ func (e *Executor) RunScan() error {
 	colIndexes := job.Metadata.GetColumnIndexes()
 	inputMetadata := job.Metadata
 
+	// the connector gets the filters it can take as predicates, the engine evaluates those it does not handle
+	var scanFilters []*planner.BooleanExpressionNode
+	var predicates []*filter.Predicate
+	var predicateFilters []*planner.BooleanExpressionNode
+	for _, be := range job.Filters {
+		if p := be.ToPredicate(job.Metadata); p != nil {
+			predicates = append(predicates, p)
+			predicateFilters = append(predicateFilters, be)
+		} else {
+			scanFilters = append(scanFilters, be)
+		}
+	}
+	for i, handled := range ctr.HandledFilters(predicates) {
+		if !handled {
+			scanFilters = append(scanFilters, predicateFilters[i])
+		}
+	}
+
+	// the rows of a push down come from the connector after the filters, grouped rows get their keys here
+	var pushDowner connector.PushDowner
+	var groupIndexes []int
+	if job.PushDown != nil {
+		var ok bool
+		if pushDowner, ok = ctr.(connector.PushDowner); !ok {
+			return fmt.Errorf("scan: connector of %s.%s.%s takes no push down", job.Catalog, job.Schema, job.Table)
+		}
+		if len(scanFilters) > 0 {
+			return fmt.Errorf("scan: connector of %s.%s.%s does not handle the filters of its push down", job.Catalog, job.Schema, job.Table)
+		}
+		inputMetadata = job.Metadata.Copy()
+		inputMetadata.ClearKeys()
+		for _, column := range job.PushDown.GroupBy {
+			index, err := inputMetadata.GetIndexByName(column)
+			if err != nil {
+				return err
+			}
+			groupIndexes = append(groupIndexes, index)
+		}
+	}
+
 	rbWriters := make([]*row.RowsBuffer, len(e.Writers))
 	for i, writer := range e.Writers {
 		rbWriters[i] = row.NewRowsBuffer(job.Metadata, nil, writer)
 	}
 
-	for _, filter := range job.Filters {
-		if err := filter.Init(job.Metadata); err != nil {
+	for _, be := range scanFilters {
+		if err := be.Init(job.Metadata); err != nil {
 			return err
 		}
 	}
@@ -70,8 +113,8 @@ func (e *Executor) RunScan() error {
 			for {
 				rg, ok := <-jobs
 				if ok {
-					for _, filter := range job.Filters { //TODO: improve performance, add flag in RowsGroup?
-						iFlags, err := filter.Result(rg)
+					for _, be := range scanFilters { //TODO: improve performance, add flag in RowsGroup?
+						iFlags, err := be.Result(rg)
 						if err != nil {
 							e.AddLogInfo(err, pb.LogLevel_ERR)
 							break
@@ -97,16 +140,15 @@ func (e *Executor) RunScan() error {
 		}(rbWriter)
 	}
 
-	var filters []string
-	for _, filter := range job.Filters {
-		filters = append(filters, filter.Clause)
-	}
-
 	// no partitions
 	if !job.Partition.IsPartition() {
 		for _, file := range job.Partition.GetNoPartitionFiles() {
 			var reader row.GroupReader
-			reader, err = ctr.GetReader(file, inputMetadata, filters)
+			if pushDowner != nil {
+				reader, err = pushDowner.GetPushDownReader(file, inputMetadata, predicates, job.PushDown)
+			} else {
+				reader, err = ctr.GetReader(file, inputMetadata, predicates)
+			}
 			if err != nil {
 				break
 			}
@@ -121,29 +163,34 @@ func (e *Executor) RunScan() error {
 					break
 				}
 
+				if len(groupIndexes) > 0 {
+					rg.AppendKeyColumns(pushDownKeys(rg, groupIndexes))
+				}
+
 				jobs <- rg
 
 			}
 		}
+	} else if pushDowner != nil {
+		err = fmt.Errorf("scan: push down into partitioned table %s.%s.%s", job.Catalog, job.Schema, job.Table)
 	} else { // partitioned
-		parColNum := job.Partition.GetPartitionColumnNum()
-		totColNum := inputMetadata.GetColumnNumber()
-		dataColNum := totColNum - parColNum
+		// the scanned columns are the selected data columns followed by the selected partition columns
 		var dataCols []int
 		var parCols []int
+		var parIndexes []int
 
 		for _, index := range colIndexes {
-			if index < dataColNum {
-				dataCols = append(dataCols, index) //column from input
+			name := inputMetadata.Columns[index].ColumnName
+			if parIndex, err := job.Partition.Metadata.GetIndexByName(name); err == nil {
+				parCols = append(parCols, parIndex) //column from partition
+				parIndexes = append(parIndexes, index)
 			} else {
-				parCols = append(parCols, index-dataColNum) //column from partition
+				dataCols = append(dataCols, index) //column from input
 			}
 		}
-		parMD := inputMetadata.SelectColumnsByIndexes(parCols)
-
-		for i := totColNum - 1; i >= dataColNum; i-- {
-			inputMetadata.DeleteColumnByIndex(i)
-		}
+		parMD := inputMetadata.SelectColumnsByIndexes(parIndexes)
+		inputMetadata = inputMetadata.SelectColumnsByIndexes(dataCols)
+		dataCols = inputMetadata.GetColumnIndexes()
 
 		for i := 0; i < job.Partition.GetPartitionNum(); i++ {
 			parFullRow := job.Partition.GetPartitionRow(i)
@@ -154,7 +201,7 @@ func (e *Executor) RunScan() error {
 
 			for _, file := range job.Partition.GetPartitionFiles(i) {
 				var reader row.GroupReader
-				reader, err = ctr.GetReader(file, inputMetadata, filters)
+				reader, err = ctr.GetReader(file, inputMetadata, predicates)
 				if err != nil {
 					break
 				}
@@ -196,3 +243,16 @@ func (e *Executor) RunScan() error {
 	}
 	return nil
 }
+
+// pushDownKeys returns the group keys of rows aggregated by a connector, made like the keys of a group by.
+func pushDownKeys(rg *row.RowsGroup, groupIndexes []int) []interface{} {
+	keys := make([]interface{}, rg.GetRowsNumber())
+	for i := range keys {
+		key := ""
+		for _, index := range groupIndexes {
+			key += fmt.Sprintf("%v:", rg.Vals[index][i])
+		}
+		keys[i] = key
+	}
+	return keys
+}
@@ -0,0 +1,145 @@
+// Package filter holds the typed predicates the engine pushes down to the connectors.
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gotodb/gotodb/datatype"
+)
+
+type Kind int
+
+const (
+	_ Kind = iota
+	And
+	Or
+	Not
+	Compare
+	In
+	Between
+	Like
+	IsNull
+)
+
+// Predicate is a node of a filter tree. And, Or and Not combine their Children, the other kinds
+// test Column against the literal Values: one for Compare, the list of In, the bounds of Between
+// and the pattern of Like followed by its escape if it has one. Literals are int64, float64,
+// string or bool, never nil.
+type Predicate struct {
+	Kind     Kind
+	Children []*Predicate
+	Column   string
+	Operator datatype.Operator
+	Values   []interface{}
+}
+
+func NewAnd(children ...*Predicate) *Predicate {
+	return &Predicate{Kind: And, Children: children}
+}
+
+func NewOr(children ...*Predicate) *Predicate {
+	return &Predicate{Kind: Or, Children: children}
+}
+
+func NewNot(child *Predicate) *Predicate {
+	return &Predicate{Kind: Not, Children: []*Predicate{child}}
+}
+
+func NewCompare(column string, op datatype.Operator, value interface{}) *Predicate {
+	return &Predicate{Kind: Compare, Column: column, Operator: op, Values: []interface{}{value}}
+}
+
+func NewIn(column string, values ...interface{}) *Predicate {
+	return &Predicate{Kind: In, Column: column, Values: values}
+}
+
+func NewBetween(column string, lower, upper interface{}) *Predicate {
+	return &Predicate{Kind: Between, Column: column, Values: []interface{}{lower, upper}}
+}
+
+func NewLike(column string, pattern string, escape *string) *Predicate {
+	res := &Predicate{Kind: Like, Column: column, Values: []interface{}{pattern}}
+	if escape != nil {
+		res.Values = append(res.Values, *escape)
+	}
+	return res
+}
+
+func NewIsNull(column string) *Predicate {
+	return &Predicate{Kind: IsNull, Column: column}
+}
+
+// OperatorString returns the SQL spelling of a comparison operator.
+func OperatorString(op datatype.Operator) string {
+	switch op {
+	case datatype.EQ:
+		return "="
+	case datatype.NEQ:
+		return "<>"
+	case datatype.LT:
+		return "<"
+	case datatype.LTE:
+		return "<="
+	case datatype.GT:
+		return ">"
+	case datatype.GTE:
+		return ">="
+	}
+	return "?"
+}
+
+// Columns returns the columns the predicate tests.
+func (p *Predicate) Columns() []string {
+	if p.Column != "" {
+		return []string{p.Column}
+	}
+	var res []string
+	for _, child := range p.Children {
+		res = append(res, child.Columns()...)
+	}
+	return res
+}
+
+func (p *Predicate) String() string {
+	switch p.Kind {
+	case And, Or:
+		op := " AND "
+		if p.Kind == Or {
+			op = " OR "
+		}
+		items := make([]string, len(p.Children))
+		for i, child := range p.Children {
+			items[i] = child.String()
+		}
+		return "(" + strings.Join(items, op) + ")"
+	case Not:
+		return "NOT " + p.Children[0].String()
+	case Compare:
+		return fmt.Sprintf("%s %s %s", p.Column, OperatorString(p.Operator), literal(p.Values[0]))
+	case In:
+		items := make([]string, len(p.Values))
+		for i, v := range p.Values {
+			items[i] = literal(v)
+		}
+		return fmt.Sprintf("%s IN (%s)", p.Column, strings.Join(items, ", "))
+	case Between:
+		return fmt.Sprintf("%s BETWEEN %s AND %s", p.Column, literal(p.Values[0]), literal(p.Values[1]))
+	case Like:
+		res := fmt.Sprintf("%s LIKE %s", p.Column, literal(p.Values[0]))
+		if len(p.Values) > 1 {
+			res += " ESCAPE " + literal(p.Values[1])
+		}
+		return res
+	case IsNull:
+		return p.Column + " IS NULL"
+	}
+	return "?"
+}
+
+func literal(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	return fmt.Sprintf("%v", v)
+}
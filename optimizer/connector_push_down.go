@@ -0,0 +1,308 @@
+package optimizer
+
+import (
+	"github.com/gotodb/gotodb/connector"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/planner"
+)
+
+// ConnectorPushDown offers the partial aggregations, limits and top-Ns right above a scan to its
+// connector. A taken aggregation replaces the local aggregation below the global one, limits and
+// top-Ns only cut the rows of every scan task as the limit and order by nodes are kept. Filters
+// right above a scan lose the predicates its connector handles.
+func ConnectorPushDown(node planner.Plan) error {
+	if node == nil {
+		return nil
+	}
+	switch node := node.(type) {
+	case *planner.AggregateFuncGlobalPlan:
+		if local, ok := node.Input.(*planner.AggregateFuncLocalPlan); ok {
+			if scan := pushDownAggregate(local); scan != nil {
+				node.Input = scan
+				scan.SetOutput(node)
+				return nil
+			}
+		}
+
+	case *planner.LimitPlan:
+		pushDownLimit(node)
+
+	case *planner.FilterPlan:
+		for _, be := range node.BooleanExpressions {
+			if be.IsSetSubQuery() {
+				if err := ConnectorPushDown(be.Predicated.Predicate.QueryPlan); err != nil {
+					return err
+				}
+			}
+		}
+		pruneFilter(node)
+	}
+
+	for _, input := range node.GetInputs() {
+		if err := ConnectorPushDown(input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pushDownAggregate(local *planner.AggregateFuncLocalPlan) *planner.ScanPlan {
+	input := local.Input
+	var groupBy *planner.GroupByPlan
+	if node, ok := input.(*planner.GroupByPlan); ok {
+		groupBy = node
+		input = node.Input
+	}
+	scan := pushDownScan(input)
+	if scan == nil {
+		return nil
+	}
+	md := scan.GetMetadata()
+
+	pushDown := &connector.PushDown{}
+	if groupBy != nil {
+		for _, element := range groupBy.GroupBy.GroupingElements {
+			column := pushDownColumn(element.Expression)
+			index, err := md.GetIndexByName(column)
+			if column == "" || err != nil {
+				return nil
+			}
+			pushDown.GroupBy = append(pushDown.GroupBy, md.Columns[index].ColumnName)
+		}
+	}
+
+	for _, f := range local.FuncNodes {
+		if f.SetQuantifier != nil && *f.SetQuantifier == datatype.DISTINCT || len(f.Expressions) != 1 {
+			return nil
+		}
+		aggregate := &connector.PushDownAggregate{Func: f.FuncName}
+		switch f.FuncName {
+		case "COUNT", "SUM", "MIN", "MAX":
+		default:
+			return nil
+		}
+		if column := pushDownColumn(f.Expressions[0]); column != "" {
+			index, err := md.GetIndexByName(column)
+			if err != nil {
+				return nil
+			}
+			aggregate.Column = md.Columns[index].ColumnName
+		} else if literal := f.Expressions[0].GetLiteral(); f.FuncName != "COUNT" || !isNumber(literal) {
+			return nil
+		}
+		pushDown.Aggregates = append(pushDown.Aggregates, aggregate)
+	}
+
+	if !scan.Connector.(connector.PushDowner).CanPushDown(pushDown) {
+		return nil
+	}
+	scan.PushDown = pushDown
+	scan.Metadata = local.GetMetadata().Copy()
+	return scan
+}
+
+func pushDownLimit(limit *planner.LimitPlan) {
+	if limit.LimitNumber == nil {
+		return
+	}
+	input := limit.Input
+	orderBy, _ := input.(*planner.OrderByPlan)
+	if orderBy != nil {
+		input = orderBy.Input
+	}
+	selectNode, ok := input.(*planner.SelectPlan)
+	if !ok || selectNode.IsAggregate || selectNode.Having != nil ||
+		selectNode.SetQuantifier != nil && *selectNode.SetQuantifier == datatype.DISTINCT {
+		return
+	}
+	scan := pushDownScan(selectNode.Input)
+	if scan == nil {
+		return
+	}
+
+	pushDown := &connector.PushDown{Limit: limit.LimitNumber}
+	if orderBy != nil {
+		for _, item := range orderBy.SortItems {
+			column := pushDownSelectColumn(selectNode, pushDownColumn(item.Expression))
+			if column == "" {
+				return
+			}
+			pushDown.OrderBy = append(pushDown.OrderBy, &connector.PushDownSortItem{
+				Column: column,
+				Desc:   item.OrderType == datatype.DESC,
+			})
+		}
+	}
+
+	if scan.Connector.(connector.PushDowner).CanPushDown(pushDown) {
+		scan.PushDown = pushDown
+	}
+}
+
+// pushDownScan returns the scan below filters whose predicates are all pushed into it, nil if
+// there is none or its connector takes no push downs.
+func pushDownScan(node planner.Plan) *planner.ScanPlan {
+	var filters []*planner.FilterPlan
+	for {
+		filter, ok := node.(*planner.FilterPlan)
+		if !ok {
+			break
+		}
+		filters = append(filters, filter)
+		node = filter.Input
+	}
+	scan, ok := node.(*planner.ScanPlan)
+	if !ok || scan.PushDown != nil {
+		return nil
+	}
+	if _, ok = scan.Connector.(connector.PushDowner); !ok {
+		return nil
+	}
+
+	handled := handledFilters(scan)
+	if len(handled) != len(scan.Filters) {
+		return nil
+	}
+	pushed := map[*planner.BooleanExpressionNode]bool{}
+	for _, f := range scan.Filters {
+		pushed[f] = true
+	}
+	for _, filter := range filters {
+		for _, be := range filter.BooleanExpressions {
+			if be.IsSetSubQuery() {
+				return nil
+			}
+			for _, predicate := range ExtractPredicates(be, datatype.AND) {
+				if !pushed[predicate] {
+					return nil
+				}
+			}
+		}
+	}
+	return scan
+}
+
+// handledFilters returns the filters of a scan its connector applies exactly.
+func handledFilters(scan *planner.ScanPlan) map[*planner.BooleanExpressionNode]bool {
+	res := map[*planner.BooleanExpressionNode]bool{}
+	if scan.Connector == nil {
+		return res
+	}
+	var filters []*planner.BooleanExpressionNode
+	var predicates []*filter.Predicate
+	for _, be := range scan.Filters {
+		if p := be.ToPredicate(scan.GetMetadata()); p != nil {
+			filters = append(filters, be)
+			predicates = append(predicates, p)
+		}
+	}
+	for i, handled := range scan.Connector.HandledFilters(predicates) {
+		if handled {
+			res[filters[i]] = true
+		}
+	}
+	return res
+}
+
+// pruneFilter drops the predicates of a filter in a chain of filters above a scan which the
+// connector handles, and the filter itself once it has none left.
+func pruneFilter(node *planner.FilterPlan) {
+	var scan *planner.ScanPlan
+	for input := node.Input; scan == nil; {
+		switch n := input.(type) {
+		case *planner.FilterPlan:
+			input = n.Input
+		case *planner.ScanPlan:
+			scan = n
+		default:
+			return
+		}
+	}
+	handled := handledFilters(scan)
+	if len(handled) == 0 {
+		return
+	}
+
+	var rest []*planner.BooleanExpressionNode
+	for _, be := range node.BooleanExpressions {
+		conjuncts := splitAnd(be)
+		var unhandled []*planner.BooleanExpressionNode
+		for _, conjunct := range conjuncts {
+			if !handled[conjunct] {
+				unhandled = append(unhandled, conjunct)
+			}
+		}
+		if len(unhandled) == len(conjuncts) {
+			rest = append(rest, be)
+		} else {
+			rest = append(rest, unhandled...)
+		}
+	}
+	node.BooleanExpressions = rest
+
+	parent := node.Output
+	if len(rest) > 0 || parent == nil {
+		return
+	}
+	inputs := parent.GetInputs()
+	for i := range inputs {
+		if inputs[i] == planner.Plan(node) {
+			inputs[i] = node.Input
+		}
+	}
+	parent.SetInputs(inputs)
+	node.Input.SetOutput(parent)
+}
+
+// splitAnd returns the operands of the ANDs of a predicate, unlike ExtractPredicates with its subqueries.
+func splitAnd(be *planner.BooleanExpressionNode) []*planner.BooleanExpressionNode {
+	if b := be.BinaryBooleanExpression; b != nil && *b.Operator == datatype.AND {
+		return append(splitAnd(b.LeftBooleanExpression), splitAnd(b.RightBooleanExpression)...)
+	}
+	return []*planner.BooleanExpressionNode{be}
+}
+
+// pushDownSelectColumn returns the scan column a column of the select output is, "" if it is computed.
+func pushDownSelectColumn(node *planner.SelectPlan, name string) string {
+	index, err := node.GetMetadata().GetIndexByName(name)
+	if name == "" || err != nil {
+		return ""
+	}
+	md := node.Input.GetMetadata()
+	for _, item := range node.SelectItems {
+		if item.Expression == nil {
+			if index < md.GetColumnNumber() {
+				return md.Columns[index].ColumnName
+			}
+			index -= md.GetColumnNumber()
+			continue
+		}
+		if index == 0 {
+			if column := pushDownColumn(item.Expression); column != "" {
+				if i, err := md.GetIndexByName(column); err == nil {
+					return md.Columns[i].ColumnName
+				}
+			}
+			return ""
+		}
+		index--
+	}
+	return ""
+}
+
+// pushDownColumn returns the name of the column an expression only references, "" for any other expression.
+func pushDownColumn(e *planner.ExpressionNode) string {
+	if e == nil {
+		return ""
+	}
+	return e.GetColumnName()
+}
+
+func isNumber(v interface{}) bool {
+	switch v.(type) {
+	case int64, float64:
+		return true
+	}
+	return false
+}
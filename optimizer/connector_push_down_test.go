@@ -0,0 +1,115 @@
+package optimizer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector"
+	"github.com/gotodb/gotodb/pkg/parser"
+	"github.com/gotodb/gotodb/planner"
+	"gopkg.in/yaml.v3"
+)
+
+func pushDownPlan(t *testing.T, sqlStr string) planner.Plan {
+	p := parser.NewSqlParser(antlr.NewCommonTokenStream(parser.NewSqlLexer(parser.NewCaseChangingStream(antlr.NewInputStream(sqlStr), true)), antlr.TokenDefaultChannel))
+	errListener := parser.NewErrorListener()
+	p.AddErrorListener(errListener)
+	tree := p.SingleStatement()
+	if errListener.HasError() {
+		t.Fatal(errListener)
+	}
+	logicalTree := planner.NewPlanFromSingleStatement(config.NewRuntime(), tree)
+	if err := logicalTree.SetMetadata(); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteRenameNode(logicalTree); err != nil {
+		t.Fatal(err)
+	}
+	if err := FilterColumns(logicalTree, []string{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := PredicatePushDown(logicalTree, []*planner.BooleanExpressionNode{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ExtractAggFunc(logicalTree); err != nil {
+		t.Fatal(err)
+	}
+	if err := ConnectorPushDown(logicalTree); err != nil {
+		t.Fatal(err)
+	}
+	return logicalTree
+}
+
+func pushDownScanOf(node planner.Plan) *planner.ScanPlan {
+	for node != nil {
+		if scan, ok := node.(*planner.ScanPlan); ok {
+			return scan
+		}
+		node = node.GetInputs()[0]
+	}
+	return nil
+}
+
+func TestConnectorPushDown(t *testing.T) {
+	var properties yaml.Node
+	if err := yaml.Unmarshal([]byte("{shop.user: {column-names: [id, name, age], column-types: [INT64, STRING, INT32]}}"), &properties); err != nil {
+		t.Fatal(err)
+	}
+	if err := connector.CreateCatalog("mysql", &config.Catalog{Type: "mysql", Properties: *properties.Content[0]}, false); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = connector.DropCatalog("mysql", false)
+	}()
+
+	scan := pushDownScanOf(pushDownPlan(t, "select age, count(name), max(id) from mysql.shop.user where age > 3 group by age"))
+	expected := &connector.PushDown{
+		GroupBy:    []string{"age"},
+		Aggregates: []*connector.PushDownAggregate{{Func: "COUNT", Column: "name"}, {Func: "MAX", Column: "id"}},
+	}
+	if !reflect.DeepEqual(scan.PushDown, expected) || scan.Metadata.GetKeyNumber() != 1 || scan.Metadata.GetColumnNumber() != 5 {
+		t.Fatalf("unexpected push down %+v of %v", scan.PushDown, scan.Metadata)
+	}
+	if _, ok := scan.GetOutput().(*planner.AggregateFuncGlobalPlan); !ok {
+		t.Fatalf("unexpected output %T", scan.GetOutput())
+	}
+
+	scan = pushDownScanOf(pushDownPlan(t, "select u.name as n, age from mysql.shop.user as u order by n desc, age limit 3"))
+	limit := int64(3)
+	expected = &connector.PushDown{
+		OrderBy: []*connector.PushDownSortItem{{Column: "name", Desc: true}, {Column: "age"}},
+		Limit:   &limit,
+	}
+	if !reflect.DeepEqual(scan.PushDown, expected) {
+		t.Fatalf("unexpected push down %+v", scan.PushDown)
+	}
+
+	scan = pushDownScanOf(pushDownPlan(t, "select name from mysql.shop.user where age > 3 and 'b' > name and age + 1 < 9"))
+	filterNode, ok := scan.GetOutput().(*planner.FilterPlan)
+	if !ok || len(scan.Filters) != 3 || len(filterNode.BooleanExpressions) != 1 || filterNode.BooleanExpressions[0] != scan.Filters[2] {
+		t.Fatalf("unexpected filters %v above %v", scan.GetOutput(), scan.Filters)
+	}
+	if p := scan.Filters[1].ToPredicate(scan.GetMetadata()); p == nil || p.String() != "name < 'b'" {
+		t.Fatalf("unexpected predicate %v", p)
+	}
+	scan = pushDownScanOf(pushDownPlan(t, "select name from mysql.shop.user where age in (1, -2) or name is not null"))
+	if _, ok = scan.GetOutput().(*planner.FilterPlan); ok {
+		t.Fatalf("unexpected filter above %v", scan.Filters)
+	}
+
+	for _, sqlStr := range []string{
+		"select name from mysql.shop.user where age + 1 < 9 limit 3",
+		"select count(distinct id) from mysql.shop.user",
+		"select avg(age) from mysql.shop.user",
+		"select name from mysql.shop.user order by age + 1 limit 3",
+		"select distinct name from mysql.shop.user limit 3",
+		"select name, count(id) from mysql.shop.user group by name",
+		"select max(name) from mysql.shop.user",
+	} {
+		if scan = pushDownScanOf(pushDownPlan(t, sqlStr)); scan.PushDown != nil {
+			t.Fatalf("unexpected push down %+v of %s", scan.PushDown, sqlStr)
+		}
+	}
+}
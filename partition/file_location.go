@@ -1,6 +1,7 @@
 package partition
 
 import (
+	"path/filepath"
 	"strings"
 )
 
@@ -11,6 +12,7 @@ const (
 	FileTypeCSV
 	FileTypeParquet
 	FileTypeORC
+	FileTypeJSON
 )
 
 func StringToFileType(ts string) FileType {
@@ -21,14 +23,48 @@ func StringToFileType(ts string) FileType {
 		return FileTypeParquet
 	case "ORC":
 		return FileTypeORC
+	case "JSON", "NDJSON":
+		return FileTypeJSON
 	default:
 		return FileTypeUnknown
 	}
 }
 
+type Compression int32
+
+const (
+	CompressionNone Compression = iota
+	CompressionGzip
+	CompressionZstd
+	CompressionSnappy
+	CompressionBzip2
+)
+
+func StringToCompression(cs string) Compression {
+	switch strings.ToUpper(cs) {
+	case "GZIP", "GZ":
+		return CompressionGzip
+	case "ZSTD", "ZST":
+		return CompressionZstd
+	case "SNAPPY", "SZ":
+		return CompressionSnappy
+	case "BZIP2", "BZ2":
+		return CompressionBzip2
+	default:
+		return CompressionNone
+	}
+}
+
+// PathToCompression detects the compression of a file by its extension.
+func PathToCompression(path string) Compression {
+	return StringToCompression(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
 type FileLocation struct {
 	Location string
 	FileType FileType
+	// Compression overrides the compression detected by the file extension
+	Compression Compression
 }
 
 func NewFileLocation(loc string, ft FileType) *FileLocation {
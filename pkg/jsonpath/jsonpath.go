@@ -0,0 +1,510 @@
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Path is a compiled JSONPath expression. It supports child names (.name, ['name']), array indices
+// and unions ([0], [-1], [0,2]), slices ([1:3]), wildcards (.*, [*]), recursive descent (..name)
+// and filters of comparisons ([?(@.price < 10 && @.tag == 'a')]). A path that does not start with
+// $ is taken relative to the root, so that "data.items" is "$.data.items".
+type Path struct {
+	Expression string
+	segments   []*segment
+}
+
+type segmentKind int
+
+const (
+	segmentNames segmentKind = iota
+	segmentIndexes
+	segmentWildcard
+	segmentSlice
+	segmentFilter
+)
+
+type segment struct {
+	kind    segmentKind
+	descend bool
+	names   []string
+	indexes []int
+	slice   [3]*int
+	filter  [][]*term // terms joined by && within and by || between the inner lists
+}
+
+// term is "@path", which holds if the path selects a value, or "@path op literal".
+type term struct {
+	path    *Path
+	op      string
+	literal interface{}
+}
+
+func Compile(expression string) (*Path, error) {
+	s := strings.TrimSpace(expression)
+	if strings.HasPrefix(s, "$") {
+		s = s[1:]
+	} else if s != "" && !strings.HasPrefix(s, "[") && !strings.HasPrefix(s, ".") {
+		s = "." + s
+	}
+	p := &parser{s: s}
+	segments, err := p.segments(false)
+	if err != nil {
+		return nil, fmt.Errorf("jsonpath %s: %v", expression, err)
+	}
+	return &Path{Expression: expression, segments: segments}, nil
+}
+
+// Definite reports whether the path selects at most one value.
+func (p *Path) Definite() bool {
+	for _, seg := range p.segments {
+		if seg.descend || seg.kind == segmentWildcard || seg.kind == segmentSlice || seg.kind == segmentFilter ||
+			len(seg.names)+len(seg.indexes) > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the values the path selects in a decoded json value.
+func (p *Path) Get(v interface{}) []interface{} {
+	nodes := []interface{}{v}
+	for _, seg := range p.segments {
+		var next []interface{}
+		for _, node := range nodes {
+			if seg.descend {
+				for _, n := range descendants(node, nil) {
+					next = seg.apply(n, next)
+				}
+			} else {
+				next = seg.apply(node, next)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+func (seg *segment) apply(node interface{}, res []interface{}) []interface{} {
+	switch seg.kind {
+	case segmentNames:
+		if object, ok := node.(map[string]interface{}); ok {
+			for _, name := range seg.names {
+				if v, ok := object[name]; ok {
+					res = append(res, v)
+				}
+			}
+		}
+	case segmentIndexes:
+		if array, ok := node.([]interface{}); ok {
+			for _, index := range seg.indexes {
+				if index < 0 {
+					index += len(array)
+				}
+				if index >= 0 && index < len(array) {
+					res = append(res, array[index])
+				}
+			}
+		}
+	case segmentWildcard:
+		res = append(res, children(node)...)
+	case segmentSlice:
+		if array, ok := node.([]interface{}); ok {
+			start, end, step := 0, len(array), 1
+			if seg.slice[2] != nil && *seg.slice[2] != 0 {
+				step = *seg.slice[2]
+			}
+			if step < 0 {
+				start, end = len(array)-1, -len(array)-1
+			}
+			if seg.slice[0] != nil {
+				start = *seg.slice[0]
+			}
+			if seg.slice[1] != nil {
+				end = *seg.slice[1]
+			}
+			if start < 0 {
+				start += len(array)
+			}
+			if end < 0 {
+				end += len(array)
+			}
+			for i := start; step > 0 && i < end || step < 0 && i > end; i += step {
+				if i >= 0 && i < len(array) {
+					res = append(res, array[i])
+				}
+			}
+		}
+	case segmentFilter:
+		for _, child := range children(node) {
+			if seg.match(child) {
+				res = append(res, child)
+			}
+		}
+	}
+	return res
+}
+
+func (seg *segment) match(v interface{}) bool {
+	for _, conjunction := range seg.filter {
+		ok := true
+		for _, t := range conjunction {
+			if !t.match(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *term) match(v interface{}) bool {
+	values := t.path.Get(v)
+	if t.op == "" {
+		return len(values) > 0
+	}
+	if len(values) == 0 {
+		// a missing value differs from every literal
+		return t.op == "!="
+	}
+	value := values[0]
+
+	if t.op == "==" || t.op == "!=" {
+		equal := value == t.literal
+		if a, ok := value.(float64); ok {
+			b, ok := t.literal.(float64)
+			equal = ok && a == b
+		}
+		return equal == (t.op == "==")
+	}
+
+	var c int
+	switch a := value.(type) {
+	case float64:
+		b, ok := t.literal.(float64)
+		if !ok {
+			return false
+		}
+		c = compare(a < b, a > b)
+	case string:
+		b, ok := t.literal.(string)
+		if !ok {
+			return false
+		}
+		c = strings.Compare(a, b)
+	default:
+		return false
+	}
+	switch t.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func compare(less, greater bool) int {
+	if less {
+		return -1
+	} else if greater {
+		return 1
+	}
+	return 0
+}
+
+// children returns the values of an object in the order of their keys, or the items of an array.
+func children(node interface{}) []interface{} {
+	switch tv := node.(type) {
+	case []interface{}:
+		return tv
+	case map[string]interface{}:
+		keys := make([]string, 0, len(tv))
+		for key := range tv {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		res := make([]interface{}, len(keys))
+		for i, key := range keys {
+			res[i] = tv[key]
+		}
+		return res
+	}
+	return nil
+}
+
+// descendants returns a node and all values below it, parents before their children.
+func descendants(node interface{}, res []interface{}) []interface{} {
+	res = append(res, node)
+	for _, child := range children(node) {
+		res = descendants(child, res)
+	}
+	return res
+}
+
+type parser struct {
+	s string
+	i int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("position %d: %s", p.i, fmt.Sprintf(format, args...))
+}
+
+// segments parses segments up to the end, or in a filter up to an operator or the closing parenthesis.
+func (p *parser) segments(inFilter bool) ([]*segment, error) {
+	var res []*segment
+	for p.i < len(p.s) {
+		descend := false
+		switch {
+		case strings.HasPrefix(p.s[p.i:], ".."):
+			descend = true
+			p.i += 2
+		case p.s[p.i] == '.':
+			p.i++
+		case p.s[p.i] == '[':
+		default:
+			if inFilter {
+				return res, nil
+			}
+			return nil, p.errorf("unexpected %q", p.s[p.i])
+		}
+
+		var seg *segment
+		var err error
+		if p.i < len(p.s) && p.s[p.i] == '[' {
+			seg, err = p.bracket()
+		} else {
+			seg, err = p.name()
+		}
+		if err != nil {
+			return nil, err
+		}
+		seg.descend = descend
+		res = append(res, seg)
+	}
+	return res, nil
+}
+
+func (p *parser) name() (*segment, error) {
+	if p.i < len(p.s) && p.s[p.i] == '*' {
+		p.i++
+		return &segment{kind: segmentWildcard}, nil
+	}
+	start := p.i
+	for p.i < len(p.s) && !strings.ContainsRune(".[ )!=<>&|", rune(p.s[p.i])) {
+		p.i++
+	}
+	if p.i == start {
+		return nil, p.errorf("missing name")
+	}
+	return &segment{kind: segmentNames, names: []string{p.s[start:p.i]}}, nil
+}
+
+func (p *parser) bracket() (*segment, error) {
+	p.i++ // [
+	p.space()
+	var seg *segment
+	switch {
+	case p.i >= len(p.s):
+		return nil, p.errorf("unclosed [")
+	case p.s[p.i] == '*':
+		p.i++
+		seg = &segment{kind: segmentWildcard}
+	case p.s[p.i] == '?':
+		p.i++
+		p.space()
+		if p.i >= len(p.s) || p.s[p.i] != '(' {
+			return nil, p.errorf("expected ( after ?")
+		}
+		p.i++
+		filter, err := p.filter()
+		if err != nil {
+			return nil, err
+		}
+		seg = &segment{kind: segmentFilter, filter: filter}
+	case p.s[p.i] == '\'' || p.s[p.i] == '"':
+		seg = &segment{kind: segmentNames}
+		for {
+			name, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			seg.names = append(seg.names, name)
+			if !p.comma() {
+				break
+			}
+		}
+	default:
+		var err error
+		if seg, err = p.indexes(); err != nil {
+			return nil, err
+		}
+	}
+	p.space()
+	if p.i >= len(p.s) || p.s[p.i] != ']' {
+		return nil, p.errorf("expected ]")
+	}
+	p.i++
+	return seg, nil
+}
+
+func (p *parser) indexes() (*segment, error) {
+	var numbers []*int
+	colons := 0
+	seg := &segment{kind: segmentIndexes}
+	for {
+		p.space()
+		start := p.i
+		for p.i < len(p.s) && (p.s[p.i] == '-' || p.s[p.i] >= '0' && p.s[p.i] <= '9') {
+			p.i++
+		}
+		var number *int
+		if p.i > start {
+			n, err := strconv.Atoi(p.s[start:p.i])
+			if err != nil {
+				return nil, p.errorf("bad index %s", p.s[start:p.i])
+			}
+			number = &n
+		}
+		numbers = append(numbers, number)
+		p.space()
+		if p.i < len(p.s) && p.s[p.i] == ':' {
+			p.i++
+			colons++
+			continue
+		}
+		if colons > 0 {
+			break
+		}
+		if number == nil {
+			return nil, p.errorf("bad index")
+		}
+		seg.indexes = append(seg.indexes, *number)
+		numbers = nil
+		if !p.comma() {
+			break
+		}
+	}
+	if colons > 0 {
+		if colons > 2 || len(seg.indexes) > 0 {
+			return nil, p.errorf("bad slice")
+		}
+		seg = &segment{kind: segmentSlice}
+		copy(seg.slice[:], numbers)
+	}
+	return seg, nil
+}
+
+// filter parses the expression of a filter after its opening parenthesis and up to its closing one.
+func (p *parser) filter() ([][]*term, error) {
+	var res [][]*term
+	var conjunction []*term
+	for {
+		p.space()
+		if p.i >= len(p.s) || p.s[p.i] != '@' {
+			return nil, p.errorf("expected @")
+		}
+		p.i++
+		segments, err := p.segments(true)
+		if err != nil {
+			return nil, err
+		}
+		t := &term{path: &Path{segments: segments}}
+		p.space()
+		for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+			if strings.HasPrefix(p.s[p.i:], op) {
+				p.i += len(op)
+				t.op = op
+				if t.literal, err = p.literal(); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		conjunction = append(conjunction, t)
+
+		p.space()
+		switch {
+		case strings.HasPrefix(p.s[p.i:], "&&"):
+			p.i += 2
+		case strings.HasPrefix(p.s[p.i:], "||"):
+			p.i += 2
+			res = append(res, conjunction)
+			conjunction = nil
+		case strings.HasPrefix(p.s[p.i:], ")"):
+			p.i++
+			return append(res, conjunction), nil
+		default:
+			return nil, p.errorf("expected ), && or ||")
+		}
+	}
+}
+
+func (p *parser) literal() (interface{}, error) {
+	p.space()
+	if p.i < len(p.s) && (p.s[p.i] == '\'' || p.s[p.i] == '"') {
+		return p.quoted()
+	}
+	start := p.i
+	for p.i < len(p.s) && !strings.ContainsRune(" )&|", rune(p.s[p.i])) {
+		p.i++
+	}
+	switch word := p.s[start:p.i]; word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	default:
+		n, err := strconv.ParseFloat(word, 64)
+		if err != nil {
+			return nil, p.errorf("bad literal %s", word)
+		}
+		return n, nil
+	}
+}
+
+func (p *parser) quoted() (string, error) {
+	quote := p.s[p.i]
+	var b strings.Builder
+	for p.i++; p.i < len(p.s); p.i++ {
+		switch p.s[p.i] {
+		case quote:
+			p.i++
+			return b.String(), nil
+		case '\\':
+			if p.i+1 < len(p.s) {
+				p.i++
+			}
+		}
+		b.WriteByte(p.s[p.i])
+	}
+	return "", p.errorf("unclosed string")
+}
+
+func (p *parser) comma() bool {
+	p.space()
+	if p.i < len(p.s) && p.s[p.i] == ',' {
+		p.i++
+		p.space()
+		return true
+	}
+	return false
+}
+
+func (p *parser) space() {
+	for p.i < len(p.s) && p.s[p.i] == ' ' {
+		p.i++
+	}
+}
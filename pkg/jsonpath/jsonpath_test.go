@@ -0,0 +1,60 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(`{
+		"store": {
+			"books": [
+				{"title": "a", "price": 8, "tags": ["x"]},
+				{"title": "b", "price": 12},
+				{"title": "c", "price": 5, "tags": []}
+			],
+			"bike": {"price": 20}
+		}
+	}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"store.books[0].title":                                   "[a]",
+		"$.store.books[-1].title":                                "[c]",
+		"$['store']['books'][0,2].title":                         "[a c]",
+		"$.store.books[*].title":                                 "[a b c]",
+		"$.store.books[1:].title":                                "[b c]",
+		"$.store.books[::-2].title":                              "[c a]",
+		"$..price":                                               "[20 8 12 5]",
+		"$.store.books[?(@.price < 10)].title":                   "[a c]",
+		"$.store.books[?(@.tags)].title":                         "[a c]",
+		"$.store.books[?(@.price > 6 && @.title != 'b')]":        "[map[price:8 tags:[x] title:a]]",
+		"$.store.books[?(@.title == 'b' || @.price == 5)].price": "[12 5]",
+		"$.store.missing":                                        "[]",
+	}
+	for expression, expected := range cases {
+		p, err := Compile(expression)
+		if err != nil {
+			t.Errorf("%s: %v", expression, err)
+			continue
+		}
+		if got := fmt.Sprint(p.Get(doc)); got != expected {
+			t.Errorf("%s: expected %s, got %s", expression, expected, got)
+		}
+	}
+
+	if p, _ := Compile("store.books[0]"); !p.Definite() {
+		t.Error("expected a definite path")
+	}
+	if p, _ := Compile("$..books"); p.Definite() {
+		t.Error("expected an indefinite path")
+	}
+	for _, expression := range []string{"$.a[", "$.a[?(@.b ==)]", "$.a[1:2:3:4]", "$ a"} {
+		if _, err := Compile(expression); err == nil {
+			t.Errorf("%s: expected an error", expression)
+		}
+	}
+}
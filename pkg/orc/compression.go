@@ -0,0 +1,77 @@
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// decompress decodes a compressed orc stream, which is a sequence of chunks each
+// prefixed with a 3 byte header holding the chunk length and an "original" flag.
+func decompress(kind CompressionKind, blockSize uint64, b []byte) ([]byte, error) {
+	if kind == CompressionNone {
+		return b, nil
+	}
+
+	var res []byte
+	for len(b) > 0 {
+		if len(b) < 3 {
+			return nil, fmt.Errorf("orc: truncated compression chunk header")
+		}
+		header := uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+		original := header&1 == 1
+		length := int(header >> 1)
+		b = b[3:]
+		if length > len(b) {
+			return nil, fmt.Errorf("orc: compression chunk length %d exceeds stream", length)
+		}
+		chunk := b[:length]
+		b = b[length:]
+
+		if original {
+			res = append(res, chunk...)
+			continue
+		}
+
+		switch kind {
+		case CompressionZlib:
+			data, err := io.ReadAll(flate.NewReader(bytes.NewReader(chunk)))
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, data...)
+		case CompressionSnappy:
+			data, err := snappy.Decode(nil, chunk)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, data...)
+		case CompressionZstd:
+			decoder, err := zstd.NewReader(nil)
+			if err != nil {
+				return nil, err
+			}
+			data, err := decoder.DecodeAll(chunk, nil)
+			decoder.Close()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, data...)
+		case CompressionLz4:
+			data := make([]byte, blockSize)
+			n, err := lz4.UncompressBlock(chunk, data)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, data[:n]...)
+		default:
+			return nil, fmt.Errorf("orc: compression kind %d is not supported", kind)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,425 @@
+package orc
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The structures below mirror the subset of orc_proto.proto needed to read a file.
+
+type CompressionKind int32
+
+const (
+	CompressionNone CompressionKind = iota
+	CompressionZlib
+	CompressionSnappy
+	CompressionLzo
+	CompressionLz4
+	CompressionZstd
+)
+
+type TypeKind int32
+
+const (
+	TypeBoolean TypeKind = iota
+	TypeByte
+	TypeShort
+	TypeInt
+	TypeLong
+	TypeFloat
+	TypeDouble
+	TypeString
+	TypeBinary
+	TypeTimestamp
+	TypeList
+	TypeMap
+	TypeStruct
+	TypeUnion
+	TypeDecimal
+	TypeDate
+	TypeVarchar
+	TypeChar
+	TypeTimestampInstant
+)
+
+type StreamKind int32
+
+const (
+	StreamPresent StreamKind = iota
+	StreamData
+	StreamLength
+	StreamDictionaryData
+	StreamDictionaryCount
+	StreamSecondary
+	StreamRowIndex
+	StreamBloomFilter
+	StreamBloomFilterUTF8
+)
+
+type EncodingKind int32
+
+const (
+	EncodingDirect EncodingKind = iota
+	EncodingDictionary
+	EncodingDirectV2
+	EncodingDictionaryV2
+)
+
+type PostScript struct {
+	FooterLength         uint64
+	Compression          CompressionKind
+	CompressionBlockSize uint64
+	MetadataLength       uint64
+	Magic                string
+}
+
+type StripeInformation struct {
+	Offset       uint64
+	IndexLength  uint64
+	DataLength   uint64
+	FooterLength uint64
+	NumberOfRows uint64
+}
+
+type Type struct {
+	Kind       TypeKind
+	Subtypes   []uint32
+	FieldNames []string
+	Precision  uint32
+	Scale      uint32
+}
+
+type ColumnStatistics struct {
+	NumberOfValues uint64
+	HasNull        bool
+	// Min and Max are int64, float64, string, or int64 milliseconds since the epoch for timestamps
+	// and int64 days for dates; nil if the writer did not record them
+	Min interface{}
+	Max interface{}
+	// UTC reports whether the timestamp bounds are the wall clock of the writer read as utc, which
+	// Reader.Statistics turns into instants
+	UTC bool
+}
+
+type Footer struct {
+	HeaderLength   uint64
+	ContentLength  uint64
+	Stripes        []*StripeInformation
+	Types          []*Type
+	NumberOfRows   uint64
+	Statistics     []*ColumnStatistics
+	RowIndexStride uint32
+}
+
+type Stream struct {
+	Kind   StreamKind
+	Column uint32
+	Length uint64
+}
+
+type ColumnEncoding struct {
+	Kind           EncodingKind
+	DictionarySize uint32
+}
+
+type StripeFooter struct {
+	Streams        []*Stream
+	Columns        []*ColumnEncoding
+	WriterTimezone string
+}
+
+// fields walks the top level fields of an encoded message.
+func fields(b []byte, fn func(num protowire.Number, typ protowire.Type, v []byte, x uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var (
+			v []byte
+			x uint64
+		)
+		switch typ {
+		case protowire.VarintType:
+			x, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var x32 uint32
+			x32, n = protowire.ConsumeFixed32(b)
+			x = uint64(x32)
+		case protowire.Fixed64Type:
+			x, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if err := fn(num, typ, v, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uint32s decodes a repeated uint32 field, packed or not.
+func uint32s(typ protowire.Type, v []byte, x uint64) ([]uint32, error) {
+	if typ != protowire.BytesType {
+		return []uint32{uint32(x)}, nil
+	}
+	var res []uint32
+	for len(v) > 0 {
+		x, n := protowire.ConsumeVarint(v)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		res = append(res, uint32(x))
+		v = v[n:]
+	}
+	return res, nil
+}
+
+func parsePostScript(b []byte) (*PostScript, error) {
+	res := &PostScript{}
+	err := fields(b, func(num protowire.Number, _ protowire.Type, v []byte, x uint64) error {
+		switch num {
+		case 1:
+			res.FooterLength = x
+		case 2:
+			res.Compression = CompressionKind(x)
+		case 3:
+			res.CompressionBlockSize = x
+		case 5:
+			res.MetadataLength = x
+		case 8000:
+			res.Magic = string(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Magic != "" && res.Magic != Magic {
+		return nil, fmt.Errorf("orc: invalid postscript magic %q", res.Magic)
+	}
+	return res, nil
+}
+
+func parseFooter(b []byte) (*Footer, error) {
+	res := &Footer{}
+	err := fields(b, func(num protowire.Number, _ protowire.Type, v []byte, x uint64) error {
+		switch num {
+		case 1:
+			res.HeaderLength = x
+		case 2:
+			res.ContentLength = x
+		case 3:
+			stripe, err := parseStripeInformation(v)
+			if err != nil {
+				return err
+			}
+			res.Stripes = append(res.Stripes, stripe)
+		case 4:
+			t, err := parseType(v)
+			if err != nil {
+				return err
+			}
+			res.Types = append(res.Types, t)
+		case 6:
+			res.NumberOfRows = x
+		case 7:
+			stats, err := parseColumnStatistics(v)
+			if err != nil {
+				return err
+			}
+			res.Statistics = append(res.Statistics, stats)
+		case 8:
+			res.RowIndexStride = uint32(x)
+		}
+		return nil
+	})
+	return res, err
+}
+
+func parseStripeInformation(b []byte) (*StripeInformation, error) {
+	res := &StripeInformation{}
+	err := fields(b, func(num protowire.Number, _ protowire.Type, _ []byte, x uint64) error {
+		switch num {
+		case 1:
+			res.Offset = x
+		case 2:
+			res.IndexLength = x
+		case 3:
+			res.DataLength = x
+		case 4:
+			res.FooterLength = x
+		case 5:
+			res.NumberOfRows = x
+		}
+		return nil
+	})
+	return res, err
+}
+
+func parseType(b []byte) (*Type, error) {
+	res := &Type{}
+	err := fields(b, func(num protowire.Number, typ protowire.Type, v []byte, x uint64) error {
+		switch num {
+		case 1:
+			res.Kind = TypeKind(x)
+		case 2:
+			subtypes, err := uint32s(typ, v, x)
+			if err != nil {
+				return err
+			}
+			res.Subtypes = append(res.Subtypes, subtypes...)
+		case 3:
+			res.FieldNames = append(res.FieldNames, string(v))
+		case 5:
+			res.Precision = uint32(x)
+		case 6:
+			res.Scale = uint32(x)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// parseMetadata returns the column statistics of every stripe.
+func parseMetadata(b []byte) ([][]*ColumnStatistics, error) {
+	var res [][]*ColumnStatistics
+	err := fields(b, func(num protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+		if num != 1 {
+			return nil
+		}
+		var stripe []*ColumnStatistics
+		err := fields(v, func(num protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+			if num != 1 {
+				return nil
+			}
+			stats, err := parseColumnStatistics(v)
+			if err != nil {
+				return err
+			}
+			stripe = append(stripe, stats)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		res = append(res, stripe)
+		return nil
+	})
+	return res, err
+}
+
+func parseColumnStatistics(b []byte) (*ColumnStatistics, error) {
+	res := &ColumnStatistics{}
+	err := fields(b, func(num protowire.Number, _ protowire.Type, v []byte, x uint64) error {
+		switch num {
+		case 1:
+			res.NumberOfValues = x
+		case 2, 7: // int and date statistics
+			return fields(v, func(num protowire.Number, _ protowire.Type, _ []byte, x uint64) error {
+				switch num {
+				case 1:
+					res.Min = protowire.DecodeZigZag(x)
+				case 2:
+					res.Max = protowire.DecodeZigZag(x)
+				}
+				return nil
+			})
+		case 9: // timestamp statistics, older writers only record the local bounds
+			var local, utc [2]interface{}
+			err := fields(v, func(num protowire.Number, _ protowire.Type, _ []byte, x uint64) error {
+				switch num {
+				case 1, 2:
+					local[num-1] = protowire.DecodeZigZag(x)
+				case 3, 4:
+					utc[num-3] = protowire.DecodeZigZag(x)
+				}
+				return nil
+			})
+			if utc[0] != nil && utc[1] != nil {
+				res.Min, res.Max, res.UTC = utc[0], utc[1], true
+			} else {
+				res.Min, res.Max = local[0], local[1]
+			}
+			return err
+		case 3:
+			return fields(v, func(num protowire.Number, _ protowire.Type, _ []byte, x uint64) error {
+				switch num {
+				case 1:
+					res.Min = math.Float64frombits(x)
+				case 2:
+					res.Max = math.Float64frombits(x)
+				}
+				return nil
+			})
+		case 4, 6: // string and decimal statistics
+			return fields(v, func(num protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+				switch num {
+				case 1:
+					res.Min = string(v)
+				case 2:
+					res.Max = string(v)
+				}
+				return nil
+			})
+		case 10:
+			res.HasNull = x != 0
+		}
+		return nil
+	})
+	return res, err
+}
+
+func parseStripeFooter(b []byte) (*StripeFooter, error) {
+	res := &StripeFooter{}
+	err := fields(b, func(num protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+		switch num {
+		case 1:
+			stream := &Stream{}
+			err := fields(v, func(num protowire.Number, _ protowire.Type, _ []byte, x uint64) error {
+				switch num {
+				case 1:
+					stream.Kind = StreamKind(x)
+				case 2:
+					stream.Column = uint32(x)
+				case 3:
+					stream.Length = x
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			res.Streams = append(res.Streams, stream)
+		case 2:
+			encoding := &ColumnEncoding{}
+			err := fields(v, func(num protowire.Number, _ protowire.Type, _ []byte, x uint64) error {
+				switch num {
+				case 1:
+					encoding.Kind = EncodingKind(x)
+				case 2:
+					encoding.DictionarySize = uint32(x)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			res.Columns = append(res.Columns, encoding)
+		case 3:
+			res.WriterTimezone = string(v)
+		}
+		return nil
+	})
+	return res, err
+}
@@ -0,0 +1,442 @@
+package orc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+const Magic = "ORC"
+
+// Reader reads the primitive top level columns of an orc file stripe by stripe.
+type Reader struct {
+	r                io.ReaderAt
+	closer           io.Closer
+	PostScript       *PostScript
+	Footer           *Footer
+	StripeStatistics [][]*ColumnStatistics
+}
+
+// Field is a top level column of the file schema.
+type Field struct {
+	Name   string
+	Column uint32
+	Type   *Type
+}
+
+func Open(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	res, err := NewReader(f, info.Size())
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	res.closer = f
+	return res, nil
+}
+
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(len(Magic))+1 {
+		return nil, fmt.Errorf("orc: file too small")
+	}
+	res := &Reader{r: r}
+
+	psLen := make([]byte, 1)
+	if _, err := r.ReadAt(psLen, size-1); err != nil {
+		return nil, err
+	}
+	end := size - 1
+	b, err := res.readAt(end-int64(psLen[0]), int64(psLen[0]))
+	if err != nil {
+		return nil, err
+	}
+	if res.PostScript, err = parsePostScript(b); err != nil {
+		return nil, err
+	}
+	end -= int64(psLen[0])
+
+	if b, err = res.readCompressed(end-int64(res.PostScript.FooterLength), int64(res.PostScript.FooterLength)); err != nil {
+		return nil, err
+	}
+	if res.Footer, err = parseFooter(b); err != nil {
+		return nil, err
+	}
+	end -= int64(res.PostScript.FooterLength)
+
+	if res.PostScript.MetadataLength > 0 {
+		if b, err = res.readCompressed(end-int64(res.PostScript.MetadataLength), int64(res.PostScript.MetadataLength)); err != nil {
+			return nil, err
+		}
+		if res.StripeStatistics, err = parseMetadata(b); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(res.Footer.Types) == 0 || res.Footer.Types[0].Kind != TypeStruct {
+		return nil, fmt.Errorf("orc: root type must be a struct")
+	}
+	return res, nil
+}
+
+func (r *Reader) readAt(offset, length int64) ([]byte, error) {
+	if offset < 0 {
+		return nil, fmt.Errorf("orc: invalid offset %d", offset)
+	}
+	b := make([]byte, length)
+	if _, err := r.r.ReadAt(b, offset); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *Reader) readCompressed(offset, length int64) ([]byte, error) {
+	b, err := r.readAt(offset, length)
+	if err != nil {
+		return nil, err
+	}
+	return decompress(r.PostScript.Compression, r.PostScript.CompressionBlockSize, b)
+}
+
+func (r *Reader) Close() error {
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+	return nil
+}
+
+func (r *Reader) NumRows() uint64 {
+	return r.Footer.NumberOfRows
+}
+
+func (r *Reader) Fields() []*Field {
+	root := r.Footer.Types[0]
+	res := make([]*Field, 0, len(root.Subtypes))
+	for i, column := range root.Subtypes {
+		field := &Field{Column: column}
+		if i < len(root.FieldNames) {
+			field.Name = root.FieldNames[i]
+		}
+		if int(column) < len(r.Footer.Types) {
+			field.Type = r.Footer.Types[column]
+		}
+		res = append(res, field)
+	}
+	return res
+}
+
+func (r *Reader) NumStripes() int {
+	return len(r.Footer.Stripes)
+}
+
+// Statistics returns the statistics of a column within a stripe, or nil if the file has none.
+func (r *Reader) Statistics(stripe int, column uint32) *ColumnStatistics {
+	if stripe >= len(r.StripeStatistics) || int(column) >= len(r.StripeStatistics[stripe]) {
+		return nil
+	}
+	stats := r.StripeStatistics[stripe][column]
+	if stats == nil || stats.Min == nil || stats.Max == nil ||
+		int(column) >= len(r.Footer.Types) || r.Footer.Types[column].Kind != TypeTimestamp {
+		return stats
+	}
+
+	// the values of a timestamp without time zone are its wall clock in the writer time zone
+	footer, loc, err := r.stripeFooter(stripe)
+	if err != nil {
+		return nil
+	}
+	res := *stats
+	if stats.UTC {
+		res.Min, res.Max = wallToInstant(stats.Min.(int64), loc, true), wallToInstant(stats.Max.(int64), loc, false)
+		res.UTC = false
+	} else if footer.WriterTimezone == "" {
+		// the local bounds of old writers are in a time zone the file doesn't name
+		res.Min, res.Max = nil, nil
+	}
+	return &res
+}
+
+// wallToInstant returns the lowest, or the highest, instant in milliseconds of a wall clock in
+// loc given as milliseconds read as utc. A wall clock within a daylight saving change has two
+// instants or none, so the offsets of the days around it bound it.
+func wallToInstant(ms int64, loc *time.Location, lowest bool) int64 {
+	_, before := time.UnixMilli(ms - 24*3600*1000).In(loc).Zone()
+	_, after := time.UnixMilli(ms + 24*3600*1000).In(loc).Zone()
+	offset := before
+	if lowest == (after > before) {
+		offset = after
+	}
+	return ms - int64(offset)*1000
+}
+
+func (r *Reader) stripeFooter(i int) (*StripeFooter, *time.Location, error) {
+	info := r.Footer.Stripes[i]
+	b, err := r.readCompressed(int64(info.Offset+info.IndexLength+info.DataLength), int64(info.FooterLength))
+	if err != nil {
+		return nil, nil, err
+	}
+	footer, err := parseStripeFooter(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc := time.UTC
+	if footer.WriterTimezone != "" {
+		if loc, err = time.LoadLocation(footer.WriterTimezone); err != nil {
+			return nil, nil, err
+		}
+	}
+	return footer, loc, nil
+}
+
+// Stripe holds the decoders of the selected columns of one stripe.
+type Stripe struct {
+	NumRows uint64
+	Columns map[uint32]*ColumnReader
+}
+
+func (r *Reader) ReadStripe(i int, columns []uint32) (*Stripe, error) {
+	info := r.Footer.Stripes[i]
+	footer, loc, err := r.stripeFooter(i)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := map[uint32]bool{}
+	for _, column := range columns {
+		selected[column] = true
+	}
+
+	streams := map[uint32]map[StreamKind][]byte{}
+	offset := info.Offset
+	for _, stream := range footer.Streams {
+		if selected[stream.Column] && stream.Kind < StreamRowIndex {
+			if streams[stream.Column] == nil {
+				streams[stream.Column] = map[StreamKind][]byte{}
+			}
+			if streams[stream.Column][stream.Kind], err = r.readCompressed(int64(offset), int64(stream.Length)); err != nil {
+				return nil, err
+			}
+		}
+		offset += stream.Length
+	}
+
+	res := &Stripe{
+		NumRows: info.NumberOfRows,
+		Columns: map[uint32]*ColumnReader{},
+	}
+	for _, column := range columns {
+		if int(column) >= len(r.Footer.Types) || int(column) >= len(footer.Columns) {
+			return nil, fmt.Errorf("orc: column %d out of range", column)
+		}
+		if res.Columns[column], err = newColumnReader(r.Footer.Types[column], footer.Columns[column], streams[column], loc); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// ColumnReader decodes the values of one column, returning nil for null values.
+type ColumnReader struct {
+	present *boolRLE
+	next    func() (interface{}, error)
+}
+
+func (c *ColumnReader) Next(n int) ([]interface{}, error) {
+	res := make([]interface{}, n)
+	for i := 0; i < n; i++ {
+		if c.present != nil {
+			present, err := c.present.next()
+			if err != nil {
+				return nil, err
+			}
+			if !present {
+				continue
+			}
+		}
+		v, err := c.next()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+func newColumnReader(t *Type, encoding *ColumnEncoding, streams map[StreamKind][]byte, loc *time.Location) (*ColumnReader, error) {
+	res := &ColumnReader{}
+	if b, ok := streams[StreamPresent]; ok {
+		res.present = newBoolRLE(b)
+	}
+	v2 := encoding.Kind == EncodingDirectV2 || encoding.Kind == EncodingDictionaryV2
+	data := streams[StreamData]
+
+	switch t.Kind {
+	case TypeBoolean:
+		d := newBoolRLE(data)
+		res.next = func() (interface{}, error) {
+			return d.next()
+		}
+	case TypeByte:
+		d := newByteRLE(data)
+		res.next = func() (interface{}, error) {
+			v, err := d.next()
+			return int8(v), err
+		}
+	case TypeShort, TypeInt, TypeLong:
+		d := newIntRLE(data, true, v2)
+		res.next = func() (interface{}, error) {
+			v, err := d.next()
+			switch t.Kind {
+			case TypeShort:
+				return int16(v), err
+			case TypeInt:
+				return int32(v), err
+			}
+			return v, err
+		}
+	case TypeFloat:
+		r := &byteReader{b: data}
+		res.next = func() (interface{}, error) {
+			b, err := r.readBytes(4)
+			if err != nil {
+				return nil, err
+			}
+			return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+		}
+	case TypeDouble:
+		r := &byteReader{b: data}
+		res.next = func() (interface{}, error) {
+			b, err := r.readBytes(8)
+			if err != nil {
+				return nil, err
+			}
+			return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+		}
+	case TypeString, TypeVarchar, TypeChar, TypeBinary:
+		lengths := newIntRLE(streams[StreamLength], false, v2)
+		if encoding.Kind == EncodingDictionary || encoding.Kind == EncodingDictionaryV2 {
+			r := &byteReader{b: streams[StreamDictionaryData]}
+			dictionary := make([]string, encoding.DictionarySize)
+			for i := range dictionary {
+				length, err := lengths.next()
+				if err != nil {
+					return nil, err
+				}
+				b, err := r.readBytes(int(length))
+				if err != nil {
+					return nil, err
+				}
+				dictionary[i] = string(b)
+			}
+			d := newIntRLE(data, false, v2)
+			res.next = func() (interface{}, error) {
+				i, err := d.next()
+				if err != nil {
+					return nil, err
+				}
+				if i < 0 || i >= int64(len(dictionary)) {
+					return nil, fmt.Errorf("orc: dictionary index %d out of range", i)
+				}
+				return dictionary[i], nil
+			}
+		} else {
+			r := &byteReader{b: data}
+			res.next = func() (interface{}, error) {
+				length, err := lengths.next()
+				if err != nil {
+					return nil, err
+				}
+				b, err := r.readBytes(int(length))
+				if err != nil {
+					return nil, err
+				}
+				return string(b), nil
+			}
+		}
+	case TypeTimestamp, TypeTimestampInstant:
+		if t.Kind == TypeTimestampInstant {
+			loc = time.UTC
+		}
+		base := time.Date(2015, 1, 1, 0, 0, 0, 0, loc).Unix()
+		seconds := newIntRLE(data, true, v2)
+		nanos := newIntRLE(streams[StreamSecondary], false, v2)
+		res.next = func() (interface{}, error) {
+			sec, err := seconds.next()
+			if err != nil {
+				return nil, err
+			}
+			x, err := nanos.next()
+			if err != nil {
+				return nil, err
+			}
+			// the low 3 bits hold the number of trailing decimal zeros that were stripped
+			nano := x >> 3
+			if zeros := x & 0x07; zeros != 0 {
+				for i := int64(0); i <= zeros; i++ {
+					nano *= 10
+				}
+			}
+			// the writer truncates the seconds of instants before the epoch towards zero
+			if base+sec < 0 && nano > 999999 {
+				sec--
+			}
+			return time.Unix(base+sec, nano).In(loc), nil
+		}
+	case TypeDate:
+		d := newIntRLE(data, true, v2)
+		res.next = func() (interface{}, error) {
+			days, err := d.next()
+			if err != nil {
+				return nil, err
+			}
+			return time.Unix(days*24*3600, 0).UTC(), nil
+		}
+	case TypeDecimal:
+		r := &byteReader{b: data}
+		scales := newIntRLE(streams[StreamSecondary], true, v2)
+		res.next = func() (interface{}, error) {
+			v, err := r.readBigVarint()
+			if err != nil {
+				return nil, err
+			}
+			scale, err := scales.next()
+			if err != nil {
+				return nil, err
+			}
+			return formatDecimal(v, int(scale)), nil
+		}
+	default:
+		return nil, fmt.Errorf("orc: type kind %d is not supported", t.Kind)
+	}
+	return res, nil
+}
+
+func formatDecimal(v *big.Int, scale int) string {
+	if scale <= 0 {
+		return new(big.Int).Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-scale)), nil)).String()
+	}
+	sign := ""
+	if v.Sign() < 0 {
+		sign = "-"
+	}
+	digits := new(big.Int).Abs(v).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
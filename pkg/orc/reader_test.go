@@ -0,0 +1,423 @@
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testStripe is a stripe of a struct<id:bigint,name:string> file, nil names are nulls.
+type testStripe struct {
+	ids   []int64
+	names []interface{}
+}
+
+func compressZlib(t *testing.T, b []byte) []byte {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	header := uint32(buf.Len()) << 1
+	return append([]byte{byte(header), byte(header >> 8), byte(header >> 16)}, buf.Bytes()...)
+}
+
+func appendMessage(b []byte, num protowire.Number, msg []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, msg)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// writeTestFile writes a zlib compressed orc file using the version 1 run length encodings.
+func writeTestFile(t *testing.T, stripes []testStripe) string {
+	file := []byte(Magic)
+	var (
+		footer   []byte
+		metadata []byte
+		rows     uint64
+	)
+	for _, stripe := range stripes {
+		var ids, present, lengths, data []byte
+		var bits byte
+		var nonNull int
+		ids = append(ids, byte(256-len(stripe.ids)))
+		for _, id := range stripe.ids {
+			ids = protowire.AppendVarint(ids, protowire.EncodeZigZag(id))
+		}
+		for i, name := range stripe.names {
+			bits <<= 1
+			if name != nil {
+				bits |= 1
+				nonNull++
+				lengths = protowire.AppendVarint(lengths, uint64(len(name.(string))))
+				data = append(data, name.(string)...)
+			}
+			if i%8 == 7 || i == len(stripe.names)-1 {
+				present = append(present, bits<<(7-i%8))
+				bits = 0
+			}
+		}
+		present = append([]byte{byte(256 - len(present))}, present...)
+		lengths = append([]byte{byte(256 - nonNull)}, lengths...)
+
+		offset := uint64(len(file))
+		var stripeFooter []byte
+		for _, stream := range []struct {
+			kind   StreamKind
+			column uint64
+			data   []byte
+		}{
+			{StreamData, 1, ids},
+			{StreamPresent, 2, present},
+			{StreamLength, 2, lengths},
+			{StreamData, 2, data},
+		} {
+			b := compressZlib(t, stream.data)
+			file = append(file, b...)
+			var msg []byte
+			msg = appendVarint(msg, 1, uint64(stream.kind))
+			msg = appendVarint(msg, 2, stream.column)
+			msg = appendVarint(msg, 3, uint64(len(b)))
+			stripeFooter = appendMessage(stripeFooter, 1, msg)
+		}
+		for i := 0; i < 3; i++ {
+			stripeFooter = appendMessage(stripeFooter, 2, appendVarint(nil, 1, uint64(EncodingDirect)))
+		}
+		dataLength := uint64(len(file)) - offset
+		stripeFooter = compressZlib(t, stripeFooter)
+		file = append(file, stripeFooter...)
+
+		var info []byte
+		info = appendVarint(info, 1, offset)
+		info = appendVarint(info, 2, 0)
+		info = appendVarint(info, 3, dataLength)
+		info = appendVarint(info, 4, uint64(len(stripeFooter)))
+		info = appendVarint(info, 5, uint64(len(stripe.ids)))
+		footer = appendMessage(footer, 3, info)
+		rows += uint64(len(stripe.ids))
+
+		min, max := stripe.ids[0], stripe.ids[0]
+		for _, id := range stripe.ids {
+			if id < min {
+				min = id
+			}
+			if id > max {
+				max = id
+			}
+		}
+		var intStats, stats []byte
+		intStats = appendVarint(intStats, 1, protowire.EncodeZigZag(min))
+		intStats = appendVarint(intStats, 2, protowire.EncodeZigZag(max))
+		var stripeStats []byte
+		stripeStats = appendMessage(stripeStats, 1, appendVarint(nil, 1, uint64(len(stripe.ids))))
+		stats = appendVarint(stats, 1, uint64(len(stripe.ids)))
+		stats = appendMessage(stats, 2, intStats)
+		stripeStats = appendMessage(stripeStats, 1, stats)
+		metadata = appendMessage(metadata, 1, stripeStats)
+	}
+
+	var root []byte
+	root = appendVarint(root, 1, uint64(TypeStruct))
+	root = appendVarint(root, 2, 1)
+	root = appendVarint(root, 2, 2)
+	root = appendMessage(root, 3, []byte("id"))
+	root = appendMessage(root, 3, []byte("name"))
+	footer = appendMessage(footer, 4, root)
+	footer = appendMessage(footer, 4, appendVarint(nil, 1, uint64(TypeLong)))
+	footer = appendMessage(footer, 4, appendVarint(nil, 1, uint64(TypeString)))
+	footer = appendVarint(footer, 6, rows)
+
+	metadata = compressZlib(t, metadata)
+	footer = compressZlib(t, footer)
+	file = append(file, metadata...)
+	file = append(file, footer...)
+
+	var ps []byte
+	ps = appendVarint(ps, 1, uint64(len(footer)))
+	ps = appendVarint(ps, 2, uint64(CompressionZlib))
+	ps = appendVarint(ps, 3, 256*1024)
+	ps = appendVarint(ps, 5, uint64(len(metadata)))
+	ps = appendMessage(ps, 8000, []byte(Magic))
+	file = append(file, ps...)
+	file = append(file, byte(len(ps)))
+
+	name := filepath.Join(t.TempDir(), "test.orc")
+	if err := os.WriteFile(name, file, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func TestReader(t *testing.T) {
+	name := writeTestFile(t, []testStripe{
+		{ids: []int64{1, 2, 3}, names: []interface{}{"a", nil, "c"}},
+		{ids: []int64{-5, 100, 7, 8, 9, 10, 11, 12, 13}, names: []interface{}{"d", "e", "f", "g", "h", "i", "j", "k", nil}},
+	})
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if r.NumRows() != 12 || r.NumStripes() != 2 {
+		t.Fatalf("unexpected rows %d and stripes %d", r.NumRows(), r.NumStripes())
+	}
+	fields := r.Fields()
+	if len(fields) != 2 || fields[0].Name != "id" || fields[1].Name != "name" || fields[1].Type.Kind != TypeString {
+		t.Fatalf("unexpected fields %v", fields)
+	}
+	if stats := r.Statistics(1, 1); stats == nil || stats.Min != int64(-5) || stats.Max != int64(100) {
+		t.Fatalf("unexpected statistics %v", stats)
+	}
+
+	stripe, err := r.ReadStripe(1, []uint32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := stripe.Columns[1].Next(int(stripe.NumRows))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := stripe.Columns[2].Next(int(stripe.NumRows))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids[0] != int64(-5) || ids[8] != int64(13) {
+		t.Fatalf("unexpected ids %v", ids)
+	}
+	if names[0] != "d" || names[7] != "k" || names[8] != nil {
+		t.Fatalf("unexpected names %v", names)
+	}
+}
+
+// writeTimestampFile writes a struct<ts:timestamp> file of one stripe whose footer names zone,
+// with the given raw fields of its timestamp statistics.
+func writeTimestampFile(t *testing.T, zone string, values []time.Time, stats map[protowire.Number]int64) string {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := time.Date(2015, 1, 1, 0, 0, 0, 0, loc).Unix()
+	seconds := []byte{byte(256 - len(values))}
+	nanos := []byte{byte(256 - len(values))}
+	for _, v := range values {
+		seconds = protowire.AppendVarint(seconds, protowire.EncodeZigZag(v.Unix()-base))
+		nanos = protowire.AppendVarint(nanos, 0)
+	}
+
+	file := []byte(Magic)
+	var stripeFooter []byte
+	for _, stream := range []struct {
+		kind StreamKind
+		data []byte
+	}{
+		{StreamData, seconds},
+		{StreamSecondary, nanos},
+	} {
+		b := compressZlib(t, stream.data)
+		file = append(file, b...)
+		var msg []byte
+		msg = appendVarint(msg, 1, uint64(stream.kind))
+		msg = appendVarint(msg, 2, 1)
+		msg = appendVarint(msg, 3, uint64(len(b)))
+		stripeFooter = appendMessage(stripeFooter, 1, msg)
+	}
+	for i := 0; i < 2; i++ {
+		stripeFooter = appendMessage(stripeFooter, 2, appendVarint(nil, 1, uint64(EncodingDirect)))
+	}
+	stripeFooter = appendMessage(stripeFooter, 3, []byte(zone))
+	dataLength := uint64(len(file) - len(Magic))
+	stripeFooter = compressZlib(t, stripeFooter)
+	file = append(file, stripeFooter...)
+
+	var info, footer []byte
+	info = appendVarint(info, 1, uint64(len(Magic)))
+	info = appendVarint(info, 2, 0)
+	info = appendVarint(info, 3, dataLength)
+	info = appendVarint(info, 4, uint64(len(stripeFooter)))
+	info = appendVarint(info, 5, uint64(len(values)))
+	footer = appendMessage(footer, 3, info)
+
+	var timestampStats, columnStats, stripeStats []byte
+	for num := protowire.Number(1); num <= 6; num++ {
+		if v, ok := stats[num]; ok {
+			timestampStats = appendVarint(timestampStats, num, protowire.EncodeZigZag(v))
+		}
+	}
+	columnStats = appendVarint(columnStats, 1, uint64(len(values)))
+	columnStats = appendMessage(columnStats, 9, timestampStats)
+	stripeStats = appendMessage(stripeStats, 1, appendVarint(nil, 1, uint64(len(values))))
+	stripeStats = appendMessage(stripeStats, 1, columnStats)
+	metadata := appendMessage(nil, 1, stripeStats)
+
+	var root []byte
+	root = appendVarint(root, 1, uint64(TypeStruct))
+	root = appendVarint(root, 2, 1)
+	root = appendMessage(root, 3, []byte("ts"))
+	footer = appendMessage(footer, 4, root)
+	footer = appendMessage(footer, 4, appendVarint(nil, 1, uint64(TypeTimestamp)))
+	footer = appendVarint(footer, 6, uint64(len(values)))
+
+	metadata = compressZlib(t, metadata)
+	footer = compressZlib(t, footer)
+	file = append(file, metadata...)
+	file = append(file, footer...)
+
+	var ps []byte
+	ps = appendVarint(ps, 1, uint64(len(footer)))
+	ps = appendVarint(ps, 2, uint64(CompressionZlib))
+	ps = appendVarint(ps, 3, 256*1024)
+	ps = appendVarint(ps, 5, uint64(len(metadata)))
+	ps = appendMessage(ps, 8000, []byte(Magic))
+	file = append(file, ps...)
+	file = append(file, byte(len(ps)))
+
+	name := filepath.Join(t.TempDir(), "timestamp.orc")
+	if err = os.WriteFile(name, file, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func TestTimestampStatistics(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := []time.Time{
+		time.Date(2023, 5, 1, 8, 30, 0, 0, loc),
+		time.Date(2023, 5, 2, 23, 0, 0, 0, loc),
+	}
+	wall := func(v time.Time) int64 {
+		return time.Date(v.Year(), v.Month(), v.Day(), v.Hour(), v.Minute(), v.Second(), 0, time.UTC).UnixMilli()
+	}
+
+	// the utc bounds are the wall clock read as utc, the local ones and the nanos are ignored
+	name := writeTimestampFile(t, "Asia/Shanghai", values, map[protowire.Number]int64{
+		1: 0, 2: 1, 3: wall(values[0]), 4: wall(values[1]), 5: 999999, 6: 999999,
+	})
+	r, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	stats := r.Statistics(0, 1)
+	if stats == nil || stats.Min != values[0].UnixMilli() || stats.Max != values[1].UnixMilli() {
+		t.Fatalf("unexpected statistics %v", stats)
+	}
+	stripe, err := r.ReadStripe(0, []uint32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := stripe.Columns[1].Next(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ts[0].(time.Time).Equal(values[0]) || !ts[1].(time.Time).Equal(values[1]) {
+		t.Fatalf("unexpected values %v", ts)
+	}
+
+	// the local bounds of older writers are instants already
+	name = writeTimestampFile(t, "Asia/Shanghai", values, map[protowire.Number]int64{
+		1: values[0].UnixMilli(), 2: values[1].UnixMilli(),
+	})
+	r, err = Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if stats = r.Statistics(0, 1); stats == nil || stats.Min != values[0].UnixMilli() || stats.Max != values[1].UnixMilli() {
+		t.Fatalf("unexpected statistics %v", stats)
+	}
+}
+
+func TestWallToInstant(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 01:30 happens twice on 2023-11-05, at -04:00 and then at -05:00
+	ms := time.Date(2023, 11, 5, 1, 30, 0, 0, time.UTC).UnixMilli()
+	if got, want := wallToInstant(ms, loc, true), ms+4*3600*1000; got != want {
+		t.Fatalf("unexpected lowest instant %d, want %d", got, want)
+	}
+	if got, want := wallToInstant(ms, loc, false), ms+5*3600*1000; got != want {
+		t.Fatalf("unexpected highest instant %d, want %d", got, want)
+	}
+}
+
+func TestJavaFiles(t *testing.T) {
+	r, err := Open("testdata/TestOrcFile.testStripeLevelStats.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.NumRows() != 11000 || r.NumStripes() != 3 {
+		t.Fatalf("unexpected rows %d and stripes %d", r.NumRows(), r.NumStripes())
+	}
+	for i, want := range []struct {
+		rows    uint64
+		int1    int64
+		string1 string
+	}{{5000, 1, "one"}, {5000, 2, "two"}, {1000, 3, "three"}} {
+		if stats := r.Statistics(i, 1); stats == nil || stats.Min != want.int1 || stats.Max != want.int1 {
+			t.Fatalf("unexpected statistics %v of stripe %d", stats, i)
+		}
+		if stats := r.Statistics(i, 2); stats == nil || stats.Min != want.string1 || stats.Max != want.string1 {
+			t.Fatalf("unexpected statistics %v of stripe %d", stats, i)
+		}
+		stripe, err := r.ReadStripe(i, []uint32{1, 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ints, err := stripe.Columns[1].Next(int(stripe.NumRows))
+		if err != nil {
+			t.Fatal(err)
+		}
+		strings, err := stripe.Columns[2].Next(int(stripe.NumRows))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stripe.NumRows != want.rows || ints[want.rows-1] != int32(want.int1) || strings[want.rows-1] != want.string1 {
+			t.Fatalf("unexpected stripe %d", i)
+		}
+	}
+
+	// hive wrote the timestamps as wall clocks but the bounds in its own time zone, which the
+	// file doesn't name
+	r, err = Open("testdata/over1k_bloom.orc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if stats := r.Statistics(0, 9); stats == nil || stats.NumberOfValues != 1049 || stats.Min != nil || stats.Max != nil {
+		t.Fatalf("unexpected statistics %v", stats)
+	}
+	stripe, err := r.ReadStripe(0, []uint32{9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := stripe.Columns[9].Next(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2013, 3, 1, 9, 11, 58, 703302000, time.UTC); !ts[0].(time.Time).Equal(want) {
+		t.Fatalf("unexpected value %v, want %v", ts[0], want)
+	}
+}
@@ -0,0 +1,479 @@
+package orc
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+)
+
+type byteReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *byteReader) readByte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, io.EOF
+	}
+	r.pos++
+	return r.b[r.pos-1], nil
+}
+
+func (r *byteReader) readBytes(n int) ([]byte, error) {
+	if r.pos+n > len(r.b) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	r.pos += n
+	return r.b[r.pos-n : r.pos], nil
+}
+
+func (r *byteReader) readUvarint() (uint64, error) {
+	var res uint64
+	for shift := uint(0); ; shift += 7 {
+		b, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if shift < 64 {
+			res |= uint64(b&0x7f) << shift
+		}
+		if b < 0x80 {
+			return res, nil
+		}
+	}
+}
+
+func (r *byteReader) readVarint() (int64, error) {
+	x, err := r.readUvarint()
+	return zigzag(x), err
+}
+
+// readBigVarint reads a zigzag encoded varint of unbounded length, as used by decimals.
+func (r *byteReader) readBigVarint() (*big.Int, error) {
+	res := new(big.Int)
+	for shift := uint(0); ; shift += 7 {
+		b, err := r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		res.Or(res, new(big.Int).Lsh(big.NewInt(int64(b&0x7f)), shift))
+		if b < 0x80 {
+			break
+		}
+	}
+	negative := res.Bit(0) == 1
+	res.Rsh(res, 1)
+	if negative {
+		res.Neg(res).Sub(res, big.NewInt(1))
+	}
+	return res, nil
+}
+
+// readBigEndian reads an n byte big endian unsigned integer.
+func (r *byteReader) readBigEndian(n int) (uint64, error) {
+	b, err := r.readBytes(n)
+	if err != nil {
+		return 0, err
+	}
+	var res uint64
+	for _, c := range b {
+		res = res<<8 | uint64(c)
+	}
+	return res, nil
+}
+
+// readPacked reads n bit packed integers of the given width, starting at a byte boundary.
+func (r *byteReader) readPacked(n, width int) ([]uint64, error) {
+	res := make([]uint64, n)
+	var (
+		cur      byte
+		bitsLeft int
+		err      error
+	)
+	for i := 0; i < n; i++ {
+		var v uint64
+		for need := width; need > 0; {
+			if bitsLeft == 0 {
+				if cur, err = r.readByte(); err != nil {
+					return nil, io.ErrUnexpectedEOF
+				}
+				bitsLeft = 8
+			}
+			take := need
+			if take > bitsLeft {
+				take = bitsLeft
+			}
+			v = v<<take | uint64(cur>>(bitsLeft-take))&(1<<take-1)
+			bitsLeft -= take
+			need -= take
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+func zigzag(x uint64) int64 {
+	return int64(x>>1) ^ -int64(x&1)
+}
+
+// byteRLE decodes the run length encoding used for byte streams.
+type byteRLE struct {
+	r        byteReader
+	literals []byte
+}
+
+func newByteRLE(b []byte) *byteRLE {
+	return &byteRLE{r: byteReader{b: b}}
+}
+
+func (d *byteRLE) next() (byte, error) {
+	if len(d.literals) == 0 {
+		control, err := d.r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if control < 0x80 {
+			v, err := d.r.readByte()
+			if err != nil {
+				return 0, io.ErrUnexpectedEOF
+			}
+			for i := 0; i < int(control)+3; i++ {
+				d.literals = append(d.literals, v)
+			}
+		} else if d.literals, err = d.r.readBytes(256 - int(control)); err != nil {
+			return 0, err
+		}
+	}
+	res := d.literals[0]
+	d.literals = d.literals[1:]
+	return res, nil
+}
+
+// boolRLE decodes a byteRLE stream holding one bit per value, most significant bit first.
+type boolRLE struct {
+	bytes *byteRLE
+	cur   byte
+	bits  int
+}
+
+func newBoolRLE(b []byte) *boolRLE {
+	return &boolRLE{bytes: newByteRLE(b)}
+}
+
+func (d *boolRLE) next() (bool, error) {
+	if d.bits == 0 {
+		var err error
+		if d.cur, err = d.bytes.next(); err != nil {
+			return false, err
+		}
+		d.bits = 8
+	}
+	d.bits--
+	return d.cur>>d.bits&1 == 1, nil
+}
+
+type intRLE interface {
+	next() (int64, error)
+}
+
+func newIntRLE(b []byte, signed, v2 bool) intRLE {
+	if v2 {
+		return &intRLEv2{r: byteReader{b: b}, signed: signed}
+	}
+	return &intRLEv1{r: byteReader{b: b}, signed: signed}
+}
+
+// intRLEv1 decodes the integer run length encoding of the DIRECT and DICTIONARY column encodings.
+type intRLEv1 struct {
+	r        byteReader
+	signed   bool
+	literals []int64
+}
+
+func (d *intRLEv1) readValue() (int64, error) {
+	if d.signed {
+		return d.r.readVarint()
+	}
+	v, err := d.r.readUvarint()
+	return int64(v), err
+}
+
+func (d *intRLEv1) next() (int64, error) {
+	if len(d.literals) == 0 {
+		control, err := d.r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if control < 0x80 {
+			delta, err := d.r.readByte()
+			if err != nil {
+				return 0, io.ErrUnexpectedEOF
+			}
+			base, err := d.readValue()
+			if err != nil {
+				return 0, io.ErrUnexpectedEOF
+			}
+			for i := 0; i < int(control)+3; i++ {
+				d.literals = append(d.literals, base+int64(i)*int64(int8(delta)))
+			}
+		} else {
+			for i := 0; i < 256-int(control); i++ {
+				v, err := d.readValue()
+				if err != nil {
+					return 0, io.ErrUnexpectedEOF
+				}
+				d.literals = append(d.literals, v)
+			}
+		}
+	}
+	res := d.literals[0]
+	d.literals = d.literals[1:]
+	return res, nil
+}
+
+// intRLEv2 decodes the integer run length encoding of the DIRECT_V2 and DICTIONARY_V2 column encodings.
+type intRLEv2 struct {
+	r        byteReader
+	signed   bool
+	literals []int64
+}
+
+const (
+	rleShortRepeat = iota
+	rleDirect
+	rlePatchedBase
+	rleDelta
+)
+
+// decodeBitWidth maps the 5 bit width code of a run header to a bit width.
+func decodeBitWidth(code int) int {
+	switch {
+	case code <= 23:
+		return code + 1
+	case code == 24:
+		return 26
+	case code == 25:
+		return 28
+	case code == 26:
+		return 30
+	case code == 27:
+		return 32
+	case code == 28:
+		return 40
+	case code == 29:
+		return 48
+	case code == 30:
+		return 56
+	default:
+		return 64
+	}
+}
+
+func closestFixedBits(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 26:
+		return 26
+	case n <= 28:
+		return 28
+	case n <= 30:
+		return 30
+	case n <= 32:
+		return 32
+	case n <= 40:
+		return 40
+	case n <= 48:
+		return 48
+	case n <= 56:
+		return 56
+	default:
+		return 64
+	}
+}
+
+func (d *intRLEv2) value(x uint64) int64 {
+	if d.signed {
+		return zigzag(x)
+	}
+	return int64(x)
+}
+
+func (d *intRLEv2) next() (int64, error) {
+	if len(d.literals) == 0 {
+		first, err := d.r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		switch first >> 6 {
+		case rleShortRepeat:
+			err = d.readShortRepeat(first)
+		case rleDirect:
+			err = d.readDirect(first)
+		case rlePatchedBase:
+			err = d.readPatchedBase(first)
+		case rleDelta:
+			err = d.readDelta(first)
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+	}
+	res := d.literals[0]
+	d.literals = d.literals[1:]
+	return res, nil
+}
+
+func (d *intRLEv2) readLength(first byte) (int, error) {
+	second, err := d.r.readByte()
+	if err != nil {
+		return 0, err
+	}
+	return int(first&1)<<8 | int(second), nil
+}
+
+func (d *intRLEv2) readShortRepeat(first byte) error {
+	width := int(first>>3&0x07) + 1
+	count := int(first&0x07) + 3
+	x, err := d.r.readBigEndian(width)
+	if err != nil {
+		return err
+	}
+	v := d.value(x)
+	for i := 0; i < count; i++ {
+		d.literals = append(d.literals, v)
+	}
+	return nil
+}
+
+func (d *intRLEv2) readDirect(first byte) error {
+	width := decodeBitWidth(int(first >> 1 & 0x1f))
+	length, err := d.readLength(first)
+	if err != nil {
+		return err
+	}
+	values, err := d.r.readPacked(length+1, width)
+	if err != nil {
+		return err
+	}
+	for _, x := range values {
+		d.literals = append(d.literals, d.value(x))
+	}
+	return nil
+}
+
+func (d *intRLEv2) readPatchedBase(first byte) error {
+	width := decodeBitWidth(int(first >> 1 & 0x1f))
+	length, err := d.readLength(first)
+	if err != nil {
+		return err
+	}
+	length++
+
+	third, err := d.r.readByte()
+	if err != nil {
+		return err
+	}
+	baseWidth := int(third>>5&0x07) + 1
+	patchWidth := decodeBitWidth(int(third & 0x1f))
+
+	fourth, err := d.r.readByte()
+	if err != nil {
+		return err
+	}
+	patchGapWidth := int(fourth>>5&0x07) + 1
+	patchListLength := int(fourth & 0x1f)
+
+	// the base value is stored big endian with its most significant bit as sign
+	x, err := d.r.readBigEndian(baseWidth)
+	if err != nil {
+		return err
+	}
+	mask := uint64(1) << (uint(baseWidth)*8 - 1)
+	base := int64(x &^ mask)
+	if x&mask != 0 {
+		base = -base
+	}
+
+	values, err := d.r.readPacked(length, width)
+	if err != nil {
+		return err
+	}
+	patches, err := d.r.readPacked(patchListLength, closestFixedBits(patchWidth+patchGapWidth))
+	if err != nil {
+		return err
+	}
+
+	patchMask := uint64(1)<<uint(patchWidth) - 1
+	pos := 0
+	for _, patch := range patches {
+		pos += int(patch >> uint(patchWidth))
+		if pos >= length {
+			return fmt.Errorf("orc: patch position %d out of range %d", pos, length)
+		}
+		values[pos] |= (patch & patchMask) << uint(width)
+	}
+
+	for _, v := range values {
+		d.literals = append(d.literals, base+int64(v))
+	}
+	return nil
+}
+
+func (d *intRLEv2) readDelta(first byte) error {
+	width := int(first >> 1 & 0x1f)
+	if width != 0 {
+		width = decodeBitWidth(width)
+	}
+	length, err := d.readLength(first)
+	if err != nil {
+		return err
+	}
+
+	var base int64
+	if d.signed {
+		base, err = d.r.readVarint()
+	} else {
+		var x uint64
+		x, err = d.r.readUvarint()
+		base = int64(x)
+	}
+	if err != nil {
+		return err
+	}
+	deltaBase, err := d.r.readVarint()
+	if err != nil {
+		return err
+	}
+
+	d.literals = append(d.literals, base)
+	if width == 0 {
+		// fixed delta
+		for i := 0; i < length; i++ {
+			base += deltaBase
+			d.literals = append(d.literals, base)
+		}
+		return nil
+	}
+
+	base += deltaBase
+	d.literals = append(d.literals, base)
+	deltas, err := d.r.readPacked(length-1, width)
+	if err != nil {
+		return err
+	}
+	for _, delta := range deltas {
+		if deltaBase < 0 {
+			base -= int64(delta)
+		} else {
+			base += int64(delta)
+		}
+		d.literals = append(d.literals, base)
+	}
+	return nil
+}
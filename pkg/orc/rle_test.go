@@ -0,0 +1,88 @@
+package orc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func readInts(t *testing.T, d intRLE, n int) []int64 {
+	var res []int64
+	for i := 0; i < n; i++ {
+		v, err := d.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, v)
+	}
+	return res
+}
+
+// the examples below are taken from the ORC specification
+
+func TestIntRLEv2ShortRepeat(t *testing.T) {
+	d := newIntRLE([]byte{0x0a, 0x27, 0x10}, false, true)
+	expected := []int64{10000, 10000, 10000, 10000, 10000}
+	if res := readInts(t, d, len(expected)); !reflect.DeepEqual(res, expected) {
+		t.Fatalf("expected %v, got %v", expected, res)
+	}
+}
+
+func TestIntRLEv2Direct(t *testing.T) {
+	d := newIntRLE([]byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef}, false, true)
+	expected := []int64{23713, 43806, 57005, 48879}
+	if res := readInts(t, d, len(expected)); !reflect.DeepEqual(res, expected) {
+		t.Fatalf("expected %v, got %v", expected, res)
+	}
+}
+
+func TestIntRLEv2PatchedBase(t *testing.T) {
+	d := newIntRLE([]byte{
+		0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32, 0x3c, 0x46,
+		0x50, 0x5a, 0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8,
+	}, false, true)
+	expected := []int64{
+		2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+		2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190,
+	}
+	if res := readInts(t, d, len(expected)); !reflect.DeepEqual(res, expected) {
+		t.Fatalf("expected %v, got %v", expected, res)
+	}
+}
+
+func TestIntRLEv2Delta(t *testing.T) {
+	d := newIntRLE([]byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46}, false, true)
+	expected := []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}
+	if res := readInts(t, d, len(expected)); !reflect.DeepEqual(res, expected) {
+		t.Fatalf("expected %v, got %v", expected, res)
+	}
+}
+
+func TestIntRLEv1(t *testing.T) {
+	// a run of 100 sevens followed by a run of 100 values starting at 0 with delta 1
+	d := newIntRLE([]byte{0x61, 0x00, 0x07, 0x61, 0x01, 0x00}, false, false)
+	res := readInts(t, d, 200)
+	for i := 0; i < 100; i++ {
+		if res[i] != 7 || res[100+i] != int64(i) {
+			t.Fatalf("unexpected values %v", res)
+		}
+	}
+
+	d = newIntRLE([]byte{0xfb, 0x02, 0x03, 0x06, 0x07, 0xb}, false, false)
+	expected := []int64{2, 3, 6, 7, 11}
+	if res := readInts(t, d, len(expected)); !reflect.DeepEqual(res, expected) {
+		t.Fatalf("expected %v, got %v", expected, res)
+	}
+}
+
+func TestBoolRLE(t *testing.T) {
+	d := newBoolRLE([]byte{0xff, 0x80})
+	for i := 0; i < 8; i++ {
+		v, err := d.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != (i == 0) {
+			t.Fatalf("unexpected value %v at %d", v, i)
+		}
+	}
+}
@@ -35,31 +35,32 @@ func sqlParserInit() {
 	staticData.literalNames = []string{
 		"", "'.'", "','", "'('", "')'", "'ADD'", "'ALL'", "'ALTER'", "'ANALYZE'",
 		"'AND'", "'ANY'", "'ARRAY'", "'AS'", "'ASC'", "'AT'", "'BERNOULLI'",
-		"'BETWEEN'", "'BY'", "'CALL'", "'CASCADE'", "'CASE'", "'CAST'", "'CATALOGS'",
-		"'COALESCE'", "'COLUMN'", "'COLUMNS'", "'COMMENT'", "'COMMIT'", "'COMMITTED'",
-		"'CONSTRAINT'", "'CREATE'", "'CROSS'", "'CUBE'", "'CURRENT'", "'CURRENT_DATE'",
-		"'CURRENT_TIME'", "'CURRENT_TIMESTAMP'", "'DATA'", "'DATE'", "'DAY'",
-		"'DEALLOCATE'", "'DELETE'", "'DESC'", "'DESCRIBE'", "'DISTINCT'", "'DISTRIBUTED'",
-		"'DROP'", "'ELSE'", "'END'", "'ESCAPE'", "'EXCEPT'", "'EXCLUDING'",
-		"'EXECUTE'", "'EXISTS'", "'EXPLAIN'", "'EXTRACT'", "'FALSE'", "'FILTER'",
-		"'FIRST'", "'FOLLOWING'", "'FOR'", "'FORMAT'", "'FROM'", "'FULL'", "'FUNCTIONS'",
-		"'GRANT'", "'GRANTS'", "'GRAPHVIZ'", "'GROUP'", "'GROUPING'", "'HAVING'",
-		"'HOUR'", "'IF'", "'IN'", "'INCLUDING'", "'INNER'", "'INPUT'", "'INSERT'",
-		"'INTEGER'", "'INTERSECT'", "'INTERVAL'", "'INTO'", "'IS'", "'ISOLATION'",
-		"'JOIN'", "'LAST'", "'LATERAL'", "'LEFT'", "'LEVEL'", "'LIKE'", "'LIMIT'",
-		"'LOCALTIME'", "'LOCALTIMESTAMP'", "'LOGICAL'", "'MAP'", "'MINUTE'",
-		"'MONTH'", "'NATURAL'", "'NFC'", "'NFD'", "'NFKC'", "'NFKD'", "'NO'",
-		"'NORMALIZE'", "'NOT'", "'NULL'", "'NULLIF'", "'NULLS'", "'ON'", "'ONLY'",
-		"'OPTION'", "'OR'", "'ORDER'", "'ORDINALITY'", "'OUTER'", "'OUTPUT'",
-		"'OVER'", "'PARTITION'", "'PARTITIONS'", "'POSITION'", "'PRECEDING'",
-		"'PREPARE'", "'PRIVILEGES'", "'PROPERTIES'", "'PUBLIC'", "'RANGE'",
-		"'READ'", "'RECURSIVE'", "'RENAME'", "'REPEATABLE'", "'REPLACE'", "'RESET'",
-		"'RESTRICT'", "'REVOKE'", "'RIGHT'", "'ROLLBACK'", "'ROLLUP'", "'ROW'",
-		"'ROWS'", "'SCHEMA'", "'SCHEMAS'", "'SECOND'", "'SELECT'", "'SERIALIZABLE'",
-		"'SESSION'", "'SET'", "'SETS'", "'SHOW'", "'SMALLINT'", "'SOME'", "'START'",
-		"'STATS'", "'SUBSTRING'", "'SYSTEM'", "'TABLE'", "'TABLES'", "'TABLESAMPLE'",
-		"'TEXT'", "'THEN'", "'TIME'", "'TIMESTAMP'", "'TINYINT'", "'TO'", "'TRANSACTION'",
-		"'TRUE'", "'TRY_CAST'", "'TYPE'", "'UESCAPE'", "'UNBOUNDED'", "'UNCOMMITTED'",
+		"'BETWEEN'", "'BY'", "'CALL'", "'CASCADE'", "'CASE'", "'CAST'", "'CATALOG'",
+		"'CATALOGS'", "'COALESCE'", "'COLUMN'", "'COLUMNS'", "'COMMENT'", "'COMMIT'",
+		"'COMMITTED'", "'CONSTRAINT'", "'CREATE'", "'CROSS'", "'CUBE'", "'CURRENT'",
+		"'CURRENT_DATE'", "'CURRENT_TIME'", "'CURRENT_TIMESTAMP'", "'DATA'",
+		"'DATE'", "'DAY'", "'DEALLOCATE'", "'DELETE'", "'DESC'", "'DESCRIBE'",
+		"'DISTINCT'", "'DISTRIBUTED'", "'DROP'", "'ELSE'", "'END'", "'ESCAPE'",
+		"'EXCEPT'", "'EXCLUDING'", "'EXECUTE'", "'EXISTS'", "'EXPLAIN'", "'EXTRACT'",
+		"'FALSE'", "'FILTER'", "'FIRST'", "'FOLLOWING'", "'FOR'", "'FORMAT'",
+		"'FROM'", "'FULL'", "'FUNCTIONS'", "'GRANT'", "'GRANTS'", "'GRAPHVIZ'",
+		"'GROUP'", "'GROUPING'", "'HAVING'", "'HOUR'", "'IF'", "'IN'", "'INCLUDING'",
+		"'INNER'", "'INPUT'", "'INSERT'", "'INTEGER'", "'INTERSECT'", "'INTERVAL'",
+		"'INTO'", "'IS'", "'ISOLATION'", "'JOIN'", "'LAST'", "'LATERAL'", "'LEFT'",
+		"'LEVEL'", "'LIKE'", "'LIMIT'", "'LOCALTIME'", "'LOCALTIMESTAMP'", "'LOGICAL'",
+		"'MAP'", "'METADATA'", "'MINUTE'", "'MONTH'", "'NATURAL'", "'NFC'",
+		"'NFD'", "'NFKC'", "'NFKD'", "'NO'", "'NORMALIZE'", "'NOT'", "'NULL'",
+		"'NULLIF'", "'NULLS'", "'ON'", "'ONLY'", "'OPTION'", "'OR'", "'ORDER'",
+		"'ORDINALITY'", "'OUTER'", "'OUTPUT'", "'OVER'", "'PARTITION'", "'PARTITIONS'",
+		"'POSITION'", "'PRECEDING'", "'PREPARE'", "'PRIVILEGES'", "'PROPERTIES'",
+		"'PUBLIC'", "'RANGE'", "'READ'", "'RECURSIVE'", "'REFRESH'", "'RENAME'",
+		"'REPEATABLE'", "'REPLACE'", "'RESET'", "'RESTRICT'", "'REVOKE'", "'RIGHT'",
+		"'ROLLBACK'", "'ROLLUP'", "'ROW'", "'ROWS'", "'SCHEMA'", "'SCHEMAS'",
+		"'SECOND'", "'SELECT'", "'SERIALIZABLE'", "'SESSION'", "'SET'", "'SETS'",
+		"'SHOW'", "'SMALLINT'", "'SOME'", "'START'", "'STATS'", "'SUBSTRING'",
+		"'SYSTEM'", "'TABLE'", "'TABLES'", "'TABLESAMPLE'", "'TEXT'", "'THEN'",
+		"'TIME'", "'TIMESTAMP'", "'TINYINT'", "'TO'", "'TRANSACTION'", "'TRUE'",
+		"'TRY_CAST'", "'TYPE'", "'UESCAPE'", "'UNBOUNDED'", "'UNCOMMITTED'",
 		"'UNION'", "'UNNEST'", "'USE'", "'USING'", "'VALIDATE'", "'VALUES'",
 		"'VERBOSE'", "'VIEW'", "'WHEN'", "'WHERE'", "'WITH'", "'WORK'", "'WRITE'",
 		"'YEAR'", "'ZONE'", "'='", "", "'<'", "'<='", "'>'", "'>='", "'+'",
@@ -68,35 +69,35 @@ func sqlParserInit() {
 	staticData.symbolicNames = []string{
 		"", "", "", "", "", "ADD", "ALL", "ALTER", "ANALYZE", "AND", "ANY",
 		"ARRAY", "AS", "ASC", "AT", "BERNOULLI", "BETWEEN", "BY", "CALL", "CASCADE",
-		"CASE", "CAST", "CATALOGS", "COALESCE", "COLUMN", "COLUMNS", "COMMENT",
-		"COMMIT", "COMMITTED", "CONSTRAINT", "CREATE", "CROSS", "CUBE", "CURRENT",
-		"CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "DATA", "DATE",
-		"DAY", "DEALLOCATE", "DELETE", "DESC", "DESCRIBE", "DISTINCT", "DISTRIBUTED",
-		"DROP", "ELSE", "END", "ESCAPE", "EXCEPT", "EXCLUDING", "EXECUTE", "EXISTS",
-		"EXPLAIN", "EXTRACT", "FALSE", "FILTER", "FIRST", "FOLLOWING", "FOR",
-		"FORMAT", "FROM", "FULL", "FUNCTIONS", "GRANT", "GRANTS", "GRAPHVIZ",
-		"GROUP", "GROUPING", "HAVING", "HOUR", "IF", "IN", "INCLUDING", "INNER",
-		"INPUT", "INSERT", "INTEGER", "INTERSECT", "INTERVAL", "INTO", "IS",
-		"ISOLATION", "JOIN", "LAST", "LATERAL", "LEFT", "LEVEL", "LIKE", "LIMIT",
-		"LOCALTIME", "LOCALTIMESTAMP", "LOGICAL", "MAP", "MINUTE", "MONTH",
-		"NATURAL", "NFC", "NFD", "NFKC", "NFKD", "NO", "NORMALIZE", "NOT", "NULL",
-		"NULLIF", "NULLS", "ON", "ONLY", "OPTION", "OR", "ORDER", "ORDINALITY",
-		"OUTER", "OUTPUT", "OVER", "PARTITION", "PARTITIONS", "POSITION", "PRECEDING",
-		"PREPARE", "PRIVILEGES", "PROPERTIES", "PUBLIC", "RANGE", "READ", "RECURSIVE",
-		"RENAME", "REPEATABLE", "REPLACE", "RESET", "RESTRICT", "REVOKE", "RIGHT",
-		"ROLLBACK", "ROLLUP", "ROW", "ROWS", "SCHEMA", "SCHEMAS", "SECOND",
-		"SELECT", "SERIALIZABLE", "SESSION", "SET", "SETS", "SHOW", "SMALLINT",
-		"SOME", "START", "STATS", "SUBSTRING", "SYSTEM", "TABLE", "TABLES",
-		"TABLESAMPLE", "TEXT", "THEN", "TIME", "TIMESTAMP", "TINYINT", "TO",
-		"TRANSACTION", "TRUE", "TRY_CAST", "TYPE", "UESCAPE", "UNBOUNDED", "UNCOMMITTED",
-		"UNION", "UNNEST", "USE", "USING", "VALIDATE", "VALUES", "VERBOSE",
-		"VIEW", "WHEN", "WHERE", "WITH", "WORK", "WRITE", "YEAR", "ZONE", "EQ",
-		"NEQ", "LT", "LTE", "GT", "GTE", "PLUS", "MINUS", "ASTERISK", "SLASH",
-		"PERCENT", "CONCAT", "STRING", "UNICODE_STRING", "BINARY_LITERAL", "INTEGER_VALUE",
-		"DOUBLE_VALUE", "IDENTIFIER", "DIGIT_IDENTIFIER", "QUOTED_IDENTIFIER",
-		"BACKQUOTED_IDENTIFIER", "TIME_WITH_TIME_ZONE", "TIMESTAMP_WITH_TIME_ZONE",
-		"DOUBLE_PRECISION", "SIMPLE_COMMENT", "BRACKETED_COMMENT", "WS", "UNRECOGNIZED",
-		"DELIMITER",
+		"CASE", "CAST", "CATALOG", "CATALOGS", "COALESCE", "COLUMN", "COLUMNS",
+		"COMMENT", "COMMIT", "COMMITTED", "CONSTRAINT", "CREATE", "CROSS", "CUBE",
+		"CURRENT", "CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "DATA",
+		"DATE", "DAY", "DEALLOCATE", "DELETE", "DESC", "DESCRIBE", "DISTINCT",
+		"DISTRIBUTED", "DROP", "ELSE", "END", "ESCAPE", "EXCEPT", "EXCLUDING",
+		"EXECUTE", "EXISTS", "EXPLAIN", "EXTRACT", "FALSE", "FILTER", "FIRST",
+		"FOLLOWING", "FOR", "FORMAT", "FROM", "FULL", "FUNCTIONS", "GRANT",
+		"GRANTS", "GRAPHVIZ", "GROUP", "GROUPING", "HAVING", "HOUR", "IF", "IN",
+		"INCLUDING", "INNER", "INPUT", "INSERT", "INTEGER", "INTERSECT", "INTERVAL",
+		"INTO", "IS", "ISOLATION", "JOIN", "LAST", "LATERAL", "LEFT", "LEVEL",
+		"LIKE", "LIMIT", "LOCALTIME", "LOCALTIMESTAMP", "LOGICAL", "MAP", "METADATA",
+		"MINUTE", "MONTH", "NATURAL", "NFC", "NFD", "NFKC", "NFKD", "NO", "NORMALIZE",
+		"NOT", "NULL", "NULLIF", "NULLS", "ON", "ONLY", "OPTION", "OR", "ORDER",
+		"ORDINALITY", "OUTER", "OUTPUT", "OVER", "PARTITION", "PARTITIONS",
+		"POSITION", "PRECEDING", "PREPARE", "PRIVILEGES", "PROPERTIES", "PUBLIC",
+		"RANGE", "READ", "RECURSIVE", "REFRESH", "RENAME", "REPEATABLE", "REPLACE",
+		"RESET", "RESTRICT", "REVOKE", "RIGHT", "ROLLBACK", "ROLLUP", "ROW",
+		"ROWS", "SCHEMA", "SCHEMAS", "SECOND", "SELECT", "SERIALIZABLE", "SESSION",
+		"SET", "SETS", "SHOW", "SMALLINT", "SOME", "START", "STATS", "SUBSTRING",
+		"SYSTEM", "TABLE", "TABLES", "TABLESAMPLE", "TEXT", "THEN", "TIME",
+		"TIMESTAMP", "TINYINT", "TO", "TRANSACTION", "TRUE", "TRY_CAST", "TYPE",
+		"UESCAPE", "UNBOUNDED", "UNCOMMITTED", "UNION", "UNNEST", "USE", "USING",
+		"VALIDATE", "VALUES", "VERBOSE", "VIEW", "WHEN", "WHERE", "WITH", "WORK",
+		"WRITE", "YEAR", "ZONE", "EQ", "NEQ", "LT", "LTE", "GT", "GTE", "PLUS",
+		"MINUS", "ASTERISK", "SLASH", "PERCENT", "CONCAT", "STRING", "UNICODE_STRING",
+		"BINARY_LITERAL", "INTEGER_VALUE", "DOUBLE_VALUE", "IDENTIFIER", "DIGIT_IDENTIFIER",
+		"QUOTED_IDENTIFIER", "BACKQUOTED_IDENTIFIER", "TIME_WITH_TIME_ZONE",
+		"TIMESTAMP_WITH_TIME_ZONE", "DOUBLE_PRECISION", "SIMPLE_COMMENT", "BRACKETED_COMMENT",
+		"WS", "UNRECOGNIZED", "DELIMITER",
 	}
 	staticData.ruleNames = []string{
 		"singleStatement", "singleExpression", "statement", "tableElement",
@@ -112,7 +113,7 @@ func sqlParserInit() {
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 213, 717, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 216, 812, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -129,330 +130,377 @@ func sqlParserInit() {
 		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1,
 		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 169, 8, 2, 1, 2, 1, 2, 1,
 		2, 1, 2, 1, 2, 5, 2, 176, 8, 2, 10, 2, 12, 2, 179, 9, 2, 3, 2, 181, 8,
-		2, 1, 2, 1, 2, 3, 2, 185, 8, 2, 3, 2, 187, 8, 2, 1, 3, 1, 3, 3, 3, 191,
-		8, 3, 1, 4, 1, 4, 1, 4, 1, 4, 3, 4, 197, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5,
-		3, 5, 203, 8, 5, 1, 6, 1, 6, 1, 6, 1, 6, 5, 6, 209, 8, 6, 10, 6, 12, 6,
-		212, 9, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8,
-		1, 8, 1, 8, 5, 8, 226, 8, 8, 10, 8, 12, 8, 229, 9, 8, 3, 8, 231, 8, 8,
-		1, 8, 1, 8, 3, 8, 235, 8, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9,
-		243, 8, 9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9, 249, 8, 9, 1, 9, 5, 9, 252, 8,
-		9, 10, 9, 12, 9, 255, 9, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 262,
-		8, 10, 1, 11, 1, 11, 3, 11, 266, 8, 11, 1, 11, 1, 11, 3, 11, 270, 8, 11,
-		1, 12, 1, 12, 3, 12, 274, 8, 12, 1, 12, 1, 12, 1, 12, 5, 12, 279, 8, 12,
-		10, 12, 12, 12, 282, 9, 12, 1, 12, 1, 12, 1, 12, 1, 12, 5, 12, 288, 8,
-		12, 10, 12, 12, 12, 291, 9, 12, 3, 12, 293, 8, 12, 1, 12, 1, 12, 3, 12,
-		297, 8, 12, 1, 12, 1, 12, 1, 12, 3, 12, 302, 8, 12, 1, 12, 1, 12, 3, 12,
-		306, 8, 12, 1, 13, 3, 13, 309, 8, 13, 1, 13, 1, 13, 1, 13, 5, 13, 314,
-		8, 13, 10, 13, 12, 13, 317, 9, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1,
-		16, 3, 16, 325, 8, 16, 1, 16, 3, 16, 328, 8, 16, 1, 16, 1, 16, 1, 16, 1,
-		16, 1, 16, 3, 16, 335, 8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
-		1, 17, 1, 17, 1, 17, 5, 17, 346, 8, 17, 10, 17, 12, 17, 349, 9, 17, 1,
-		18, 3, 18, 352, 8, 18, 1, 18, 1, 18, 3, 18, 356, 8, 18, 1, 18, 1, 18, 3,
-		18, 360, 8, 18, 1, 18, 1, 18, 3, 18, 364, 8, 18, 3, 18, 366, 8, 18, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 5, 19, 375, 8, 19, 10, 19,
-		12, 19, 378, 9, 19, 1, 19, 1, 19, 3, 19, 382, 8, 19, 1, 20, 1, 20, 1, 21,
-		1, 21, 3, 21, 388, 8, 21, 1, 21, 3, 21, 391, 8, 21, 1, 22, 1, 22, 1, 22,
-		1, 22, 5, 22, 397, 8, 22, 10, 22, 12, 22, 400, 9, 22, 1, 22, 1, 22, 1,
-		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 413,
-		8, 23, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 421, 8, 25, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 5, 25, 429, 8, 25, 10, 25, 12, 25,
-		432, 9, 25, 1, 26, 1, 26, 3, 26, 436, 8, 26, 1, 27, 1, 27, 1, 27, 1, 27,
-		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 448, 8, 27, 1, 27, 1,
-		27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 456, 8, 27, 1, 27, 1, 27, 1, 27,
-		1, 27, 1, 27, 5, 27, 463, 8, 27, 10, 27, 12, 27, 466, 9, 27, 1, 27, 1,
-		27, 1, 27, 3, 27, 471, 8, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27,
-		3, 27, 479, 8, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 485, 8, 27, 1, 27,
-		1, 27, 3, 27, 489, 8, 27, 1, 27, 1, 27, 1, 27, 3, 27, 494, 8, 27, 1, 27,
-		1, 27, 1, 27, 3, 27, 499, 8, 27, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 505,
+		2, 1, 2, 1, 2, 3, 2, 185, 8, 2, 1, 2, 1, 2, 1, 2, 3, 2, 190, 8, 2, 1, 2,
+		1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 197, 8, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2,
+		3, 2, 204, 8, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 210, 8, 2, 1, 2, 1, 2, 1,
+		2, 1, 2, 1, 2, 1, 2, 3, 2, 218, 8, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 5,
+		2, 225, 8, 2, 10, 2, 12, 2, 228, 9, 2, 1, 2, 1, 2, 1, 2, 3, 2, 233, 8,
+		2, 1, 2, 1, 2, 3, 2, 237, 8, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 244,
+		8, 2, 1, 2, 1, 2, 1, 2, 3, 2, 249, 8, 2, 1, 2, 1, 2, 3, 2, 253, 8, 2, 1,
+		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 262, 8, 2, 1, 2, 1, 2, 1,
+		2, 1, 2, 3, 2, 268, 8, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1,
+		2, 1, 2, 3, 2, 279, 8, 2, 1, 2, 3, 2, 282, 8, 2, 1, 3, 1, 3, 3, 3, 286,
+		8, 3, 1, 4, 1, 4, 1, 4, 1, 4, 3, 4, 292, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5,
+		3, 5, 298, 8, 5, 1, 6, 1, 6, 1, 6, 1, 6, 5, 6, 304, 8, 6, 10, 6, 12, 6,
+		307, 9, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8,
+		1, 8, 1, 8, 5, 8, 321, 8, 8, 10, 8, 12, 8, 324, 9, 8, 3, 8, 326, 8, 8,
+		1, 8, 1, 8, 3, 8, 330, 8, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9,
+		338, 8, 9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9, 344, 8, 9, 1, 9, 5, 9, 347, 8,
+		9, 10, 9, 12, 9, 350, 9, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 357,
+		8, 10, 1, 11, 1, 11, 3, 11, 361, 8, 11, 1, 11, 1, 11, 3, 11, 365, 8, 11,
+		1, 12, 1, 12, 3, 12, 369, 8, 12, 1, 12, 1, 12, 1, 12, 5, 12, 374, 8, 12,
+		10, 12, 12, 12, 377, 9, 12, 1, 12, 1, 12, 1, 12, 1, 12, 5, 12, 383, 8,
+		12, 10, 12, 12, 12, 386, 9, 12, 3, 12, 388, 8, 12, 1, 12, 1, 12, 3, 12,
+		392, 8, 12, 1, 12, 1, 12, 1, 12, 3, 12, 397, 8, 12, 1, 12, 1, 12, 3, 12,
+		401, 8, 12, 1, 13, 3, 13, 404, 8, 13, 1, 13, 1, 13, 1, 13, 5, 13, 409,
+		8, 13, 10, 13, 12, 13, 412, 9, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1,
+		16, 3, 16, 420, 8, 16, 1, 16, 3, 16, 423, 8, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 3, 16, 430, 8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
+		1, 17, 1, 17, 1, 17, 5, 17, 441, 8, 17, 10, 17, 12, 17, 444, 9, 17, 1,
+		18, 3, 18, 447, 8, 18, 1, 18, 1, 18, 3, 18, 451, 8, 18, 1, 18, 1, 18, 3,
+		18, 455, 8, 18, 1, 18, 1, 18, 3, 18, 459, 8, 18, 3, 18, 461, 8, 18, 1,
+		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 5, 19, 470, 8, 19, 10, 19,
+		12, 19, 473, 9, 19, 1, 19, 1, 19, 3, 19, 477, 8, 19, 1, 20, 1, 20, 1, 21,
+		1, 21, 3, 21, 483, 8, 21, 1, 21, 3, 21, 486, 8, 21, 1, 22, 1, 22, 1, 22,
+		1, 22, 5, 22, 492, 8, 22, 10, 22, 12, 22, 495, 9, 22, 1, 22, 1, 22, 1,
+		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 508,
+		8, 23, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 516, 8, 25, 1,
+		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 5, 25, 524, 8, 25, 10, 25, 12, 25,
+		527, 9, 25, 1, 26, 1, 26, 3, 26, 531, 8, 26, 1, 27, 1, 27, 1, 27, 1, 27,
+		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 543, 8, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 551, 8, 27, 1, 27, 1, 27, 1, 27,
+		1, 27, 1, 27, 5, 27, 558, 8, 27, 10, 27, 12, 27, 561, 9, 27, 1, 27, 1,
+		27, 1, 27, 3, 27, 566, 8, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27,
+		3, 27, 574, 8, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 580, 8, 27, 1, 27,
+		1, 27, 3, 27, 584, 8, 27, 1, 27, 1, 27, 1, 27, 3, 27, 589, 8, 27, 1, 27,
+		1, 27, 1, 27, 3, 27, 594, 8, 27, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 600,
 		8, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 5,
-		28, 516, 8, 28, 10, 28, 12, 28, 519, 9, 28, 1, 29, 1, 29, 1, 29, 1, 29,
-		1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 533, 8,
-		29, 1, 29, 1, 29, 1, 29, 5, 29, 538, 8, 29, 10, 29, 12, 29, 541, 9, 29,
-		3, 29, 543, 8, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 5, 29, 550, 8, 29,
-		10, 29, 12, 29, 553, 9, 29, 3, 29, 555, 8, 29, 1, 29, 1, 29, 1, 29, 1,
-		29, 4, 29, 561, 8, 29, 11, 29, 12, 29, 562, 1, 29, 1, 29, 3, 29, 567, 8,
-		29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 575, 8, 29, 1, 29,
-		1, 29, 1, 29, 5, 29, 580, 8, 29, 10, 29, 12, 29, 583, 9, 29, 1, 30, 1,
+		28, 611, 8, 28, 10, 28, 12, 28, 614, 9, 28, 1, 29, 1, 29, 1, 29, 1, 29,
+		1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 628, 8,
+		29, 1, 29, 1, 29, 1, 29, 5, 29, 633, 8, 29, 10, 29, 12, 29, 636, 9, 29,
+		3, 29, 638, 8, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 5, 29, 645, 8, 29,
+		10, 29, 12, 29, 648, 9, 29, 3, 29, 650, 8, 29, 1, 29, 1, 29, 1, 29, 1,
+		29, 4, 29, 656, 8, 29, 11, 29, 12, 29, 657, 1, 29, 1, 29, 3, 29, 662, 8,
+		29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 670, 8, 29, 1, 29,
+		1, 29, 1, 29, 5, 29, 675, 8, 29, 10, 29, 12, 29, 678, 9, 29, 1, 30, 1,
 		30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34,
 		1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1,
-		34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 5, 34, 614, 8, 34, 10, 34,
-		12, 34, 617, 9, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 5,
-		34, 626, 8, 34, 10, 34, 12, 34, 629, 9, 34, 1, 34, 1, 34, 3, 34, 633, 8,
-		34, 3, 34, 635, 8, 34, 1, 34, 1, 34, 5, 34, 639, 8, 34, 10, 34, 12, 34,
-		642, 9, 34, 1, 35, 1, 35, 3, 35, 646, 8, 35, 1, 36, 1, 36, 1, 36, 1, 36,
-		3, 36, 652, 8, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1,
+		34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 5, 34, 709, 8, 34, 10, 34,
+		12, 34, 712, 9, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 5,
+		34, 721, 8, 34, 10, 34, 12, 34, 724, 9, 34, 1, 34, 1, 34, 3, 34, 728, 8,
+		34, 3, 34, 730, 8, 34, 1, 34, 1, 34, 5, 34, 734, 8, 34, 10, 34, 12, 34,
+		737, 9, 34, 1, 35, 1, 35, 3, 35, 741, 8, 35, 1, 36, 1, 36, 1, 36, 1, 36,
+		3, 36, 747, 8, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1,
 		38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
-		5, 39, 672, 8, 39, 10, 39, 12, 39, 675, 9, 39, 3, 39, 677, 8, 39, 1, 39,
-		1, 39, 1, 39, 1, 39, 1, 39, 5, 39, 684, 8, 39, 10, 39, 12, 39, 687, 9,
-		39, 3, 39, 689, 8, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40,
-		697, 8, 40, 1, 41, 1, 41, 1, 41, 5, 41, 702, 8, 41, 10, 41, 12, 41, 705,
-		9, 41, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 711, 8, 42, 1, 43, 1, 43, 1,
+		5, 39, 767, 8, 39, 10, 39, 12, 39, 770, 9, 39, 3, 39, 772, 8, 39, 1, 39,
+		1, 39, 1, 39, 1, 39, 1, 39, 5, 39, 779, 8, 39, 10, 39, 12, 39, 782, 9,
+		39, 3, 39, 784, 8, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40,
+		792, 8, 40, 1, 41, 1, 41, 1, 41, 5, 41, 797, 8, 41, 10, 41, 12, 41, 800,
+		9, 41, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 806, 8, 42, 1, 43, 1, 43, 1,
 		44, 1, 44, 1, 44, 0, 6, 18, 34, 50, 56, 58, 68, 45, 0, 2, 4, 6, 8, 10,
 		12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46,
 		48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82,
-		84, 86, 88, 0, 15, 2, 0, 62, 62, 73, 73, 2, 0, 6, 6, 200, 200, 2, 0, 51,
-		51, 74, 74, 2, 0, 50, 50, 170, 170, 2, 0, 13, 13, 42, 42, 2, 0, 58, 58,
-		85, 85, 2, 0, 6, 6, 44, 44, 2, 0, 15, 15, 153, 153, 1, 0, 191, 192, 1,
-		0, 193, 195, 1, 0, 185, 190, 3, 0, 6, 6, 10, 10, 149, 149, 2, 0, 56, 56,
-		164, 164, 1, 0, 200, 201, 43, 0, 5, 6, 8, 8, 10, 11, 13, 15, 18, 19, 22,
-		28, 33, 33, 37, 39, 42, 42, 45, 45, 51, 51, 54, 54, 57, 59, 61, 61, 64,
-		67, 71, 72, 74, 74, 76, 76, 78, 78, 80, 80, 83, 83, 85, 86, 88, 88, 90,
-		90, 93, 96, 98, 102, 106, 107, 109, 110, 113, 113, 115, 120, 122, 126,
-		128, 133, 135, 135, 137, 141, 143, 153, 155, 157, 159, 163, 165, 166, 168,
-		169, 172, 172, 174, 174, 176, 177, 181, 184, 798, 0, 90, 1, 0, 0, 0, 2,
-		93, 1, 0, 0, 0, 4, 186, 1, 0, 0, 0, 6, 190, 1, 0, 0, 0, 8, 192, 1, 0, 0,
-		0, 10, 198, 1, 0, 0, 0, 12, 204, 1, 0, 0, 0, 14, 215, 1, 0, 0, 0, 16, 219,
-		1, 0, 0, 0, 18, 236, 1, 0, 0, 0, 20, 261, 1, 0, 0, 0, 22, 263, 1, 0, 0,
-		0, 24, 271, 1, 0, 0, 0, 26, 308, 1, 0, 0, 0, 28, 318, 1, 0, 0, 0, 30, 320,
-		1, 0, 0, 0, 32, 334, 1, 0, 0, 0, 34, 336, 1, 0, 0, 0, 36, 365, 1, 0, 0,
-		0, 38, 381, 1, 0, 0, 0, 40, 383, 1, 0, 0, 0, 42, 385, 1, 0, 0, 0, 44, 392,
-		1, 0, 0, 0, 46, 412, 1, 0, 0, 0, 48, 414, 1, 0, 0, 0, 50, 420, 1, 0, 0,
-		0, 52, 433, 1, 0, 0, 0, 54, 498, 1, 0, 0, 0, 56, 504, 1, 0, 0, 0, 58, 574,
-		1, 0, 0, 0, 60, 584, 1, 0, 0, 0, 62, 586, 1, 0, 0, 0, 64, 588, 1, 0, 0,
-		0, 66, 590, 1, 0, 0, 0, 68, 634, 1, 0, 0, 0, 70, 645, 1, 0, 0, 0, 72, 651,
-		1, 0, 0, 0, 74, 653, 1, 0, 0, 0, 76, 658, 1, 0, 0, 0, 78, 664, 1, 0, 0,
-		0, 80, 696, 1, 0, 0, 0, 82, 698, 1, 0, 0, 0, 84, 710, 1, 0, 0, 0, 86, 712,
-		1, 0, 0, 0, 88, 714, 1, 0, 0, 0, 90, 91, 3, 4, 2, 0, 91, 92, 5, 0, 0, 1,
+		84, 86, 88, 0, 15, 2, 0, 63, 63, 74, 74, 2, 0, 6, 6, 203, 203, 2, 0, 52,
+		52, 75, 75, 2, 0, 51, 51, 173, 173, 2, 0, 13, 13, 43, 43, 2, 0, 59, 59,
+		86, 86, 2, 0, 6, 6, 45, 45, 2, 0, 15, 15, 156, 156, 1, 0, 194, 195, 1,
+		0, 196, 198, 1, 0, 188, 193, 3, 0, 6, 6, 10, 10, 152, 152, 2, 0, 57, 57,
+		167, 167, 1, 0, 203, 204, 43, 0, 5, 6, 8, 8, 10, 11, 13, 15, 18, 19, 22,
+		29, 34, 34, 38, 40, 43, 43, 46, 46, 52, 52, 55, 55, 58, 60, 62, 62, 65,
+		68, 72, 73, 75, 75, 77, 77, 79, 79, 81, 81, 84, 84, 86, 87, 89, 89, 91,
+		91, 94, 98, 100, 104, 108, 109, 111, 112, 115, 115, 117, 122, 124, 128,
+		130, 136, 138, 138, 140, 144, 146, 156, 158, 160, 162, 166, 168, 169, 171,
+		172, 175, 175, 177, 177, 179, 180, 184, 187, 915, 0, 90, 1, 0, 0, 0, 2,
+		93, 1, 0, 0, 0, 4, 281, 1, 0, 0, 0, 6, 285, 1, 0, 0, 0, 8, 287, 1, 0, 0,
+		0, 10, 293, 1, 0, 0, 0, 12, 299, 1, 0, 0, 0, 14, 310, 1, 0, 0, 0, 16, 314,
+		1, 0, 0, 0, 18, 331, 1, 0, 0, 0, 20, 356, 1, 0, 0, 0, 22, 358, 1, 0, 0,
+		0, 24, 366, 1, 0, 0, 0, 26, 403, 1, 0, 0, 0, 28, 413, 1, 0, 0, 0, 30, 415,
+		1, 0, 0, 0, 32, 429, 1, 0, 0, 0, 34, 431, 1, 0, 0, 0, 36, 460, 1, 0, 0,
+		0, 38, 476, 1, 0, 0, 0, 40, 478, 1, 0, 0, 0, 42, 480, 1, 0, 0, 0, 44, 487,
+		1, 0, 0, 0, 46, 507, 1, 0, 0, 0, 48, 509, 1, 0, 0, 0, 50, 515, 1, 0, 0,
+		0, 52, 528, 1, 0, 0, 0, 54, 593, 1, 0, 0, 0, 56, 599, 1, 0, 0, 0, 58, 669,
+		1, 0, 0, 0, 60, 679, 1, 0, 0, 0, 62, 681, 1, 0, 0, 0, 64, 683, 1, 0, 0,
+		0, 66, 685, 1, 0, 0, 0, 68, 729, 1, 0, 0, 0, 70, 740, 1, 0, 0, 0, 72, 746,
+		1, 0, 0, 0, 74, 748, 1, 0, 0, 0, 76, 753, 1, 0, 0, 0, 78, 759, 1, 0, 0,
+		0, 80, 791, 1, 0, 0, 0, 82, 793, 1, 0, 0, 0, 84, 805, 1, 0, 0, 0, 86, 807,
+		1, 0, 0, 0, 88, 809, 1, 0, 0, 0, 90, 91, 3, 4, 2, 0, 91, 92, 5, 0, 0, 1,
 		92, 1, 1, 0, 0, 0, 93, 94, 3, 48, 24, 0, 94, 95, 5, 0, 0, 1, 95, 3, 1,
-		0, 0, 0, 96, 187, 3, 16, 8, 0, 97, 98, 5, 172, 0, 0, 98, 187, 3, 84, 42,
-		0, 99, 100, 5, 172, 0, 0, 100, 101, 3, 84, 42, 0, 101, 102, 5, 1, 0, 0,
-		102, 103, 3, 84, 42, 0, 103, 187, 1, 0, 0, 0, 104, 105, 5, 77, 0, 0, 105,
-		106, 5, 81, 0, 0, 106, 108, 3, 82, 41, 0, 107, 109, 3, 44, 22, 0, 108,
+		0, 0, 0, 96, 282, 3, 16, 8, 0, 97, 98, 5, 175, 0, 0, 98, 282, 3, 84, 42,
+		0, 99, 100, 5, 175, 0, 0, 100, 101, 3, 84, 42, 0, 101, 102, 5, 1, 0, 0,
+		102, 103, 3, 84, 42, 0, 103, 282, 1, 0, 0, 0, 104, 105, 5, 78, 0, 0, 105,
+		106, 5, 82, 0, 0, 106, 108, 3, 82, 41, 0, 107, 109, 3, 44, 22, 0, 108,
 		107, 1, 0, 0, 0, 108, 109, 1, 0, 0, 0, 109, 110, 1, 0, 0, 0, 110, 111,
-		3, 16, 8, 0, 111, 187, 1, 0, 0, 0, 112, 113, 5, 147, 0, 0, 113, 116, 5,
-		155, 0, 0, 114, 115, 7, 0, 0, 0, 115, 117, 3, 82, 41, 0, 116, 114, 1, 0,
-		0, 0, 116, 117, 1, 0, 0, 0, 117, 124, 1, 0, 0, 0, 118, 119, 5, 89, 0, 0,
-		119, 122, 3, 60, 30, 0, 120, 121, 5, 49, 0, 0, 121, 123, 3, 60, 30, 0,
+		3, 16, 8, 0, 111, 282, 1, 0, 0, 0, 112, 113, 5, 150, 0, 0, 113, 116, 5,
+		158, 0, 0, 114, 115, 7, 0, 0, 0, 115, 117, 3, 82, 41, 0, 116, 114, 1, 0,
+		0, 0, 116, 117, 1, 0, 0, 0, 117, 124, 1, 0, 0, 0, 118, 119, 5, 90, 0, 0,
+		119, 122, 3, 60, 30, 0, 120, 121, 5, 50, 0, 0, 121, 123, 3, 60, 30, 0,
 		122, 120, 1, 0, 0, 0, 122, 123, 1, 0, 0, 0, 123, 125, 1, 0, 0, 0, 124,
-		118, 1, 0, 0, 0, 124, 125, 1, 0, 0, 0, 125, 187, 1, 0, 0, 0, 126, 127,
-		5, 147, 0, 0, 127, 130, 5, 140, 0, 0, 128, 129, 7, 0, 0, 0, 129, 131, 3,
+		118, 1, 0, 0, 0, 124, 125, 1, 0, 0, 0, 125, 282, 1, 0, 0, 0, 126, 127,
+		5, 150, 0, 0, 127, 130, 5, 143, 0, 0, 128, 129, 7, 0, 0, 0, 129, 131, 3,
 		84, 42, 0, 130, 128, 1, 0, 0, 0, 130, 131, 1, 0, 0, 0, 131, 138, 1, 0,
-		0, 0, 132, 133, 5, 89, 0, 0, 133, 136, 3, 60, 30, 0, 134, 135, 5, 49, 0,
+		0, 0, 132, 133, 5, 90, 0, 0, 133, 136, 3, 60, 30, 0, 134, 135, 5, 50, 0,
 		0, 135, 137, 3, 60, 30, 0, 136, 134, 1, 0, 0, 0, 136, 137, 1, 0, 0, 0,
 		137, 139, 1, 0, 0, 0, 138, 132, 1, 0, 0, 0, 138, 139, 1, 0, 0, 0, 139,
-		187, 1, 0, 0, 0, 140, 141, 5, 147, 0, 0, 141, 144, 5, 22, 0, 0, 142, 143,
-		5, 89, 0, 0, 143, 145, 3, 60, 30, 0, 144, 142, 1, 0, 0, 0, 144, 145, 1,
-		0, 0, 0, 145, 187, 1, 0, 0, 0, 146, 147, 5, 147, 0, 0, 147, 148, 5, 25,
-		0, 0, 148, 149, 7, 0, 0, 0, 149, 187, 3, 82, 41, 0, 150, 151, 5, 147, 0,
-		0, 151, 152, 5, 30, 0, 0, 152, 153, 5, 154, 0, 0, 153, 187, 3, 82, 41,
-		0, 154, 155, 5, 147, 0, 0, 155, 156, 5, 30, 0, 0, 156, 157, 5, 177, 0,
-		0, 157, 187, 3, 82, 41, 0, 158, 159, 5, 43, 0, 0, 159, 187, 3, 82, 41,
-		0, 160, 161, 5, 42, 0, 0, 161, 187, 3, 82, 41, 0, 162, 163, 5, 147, 0,
-		0, 163, 164, 5, 118, 0, 0, 164, 165, 7, 0, 0, 0, 165, 168, 3, 82, 41, 0,
-		166, 167, 5, 179, 0, 0, 167, 169, 3, 50, 25, 0, 168, 166, 1, 0, 0, 0, 168,
-		169, 1, 0, 0, 0, 169, 180, 1, 0, 0, 0, 170, 171, 5, 112, 0, 0, 171, 172,
+		282, 1, 0, 0, 0, 140, 141, 5, 150, 0, 0, 141, 144, 5, 23, 0, 0, 142, 143,
+		5, 90, 0, 0, 143, 145, 3, 60, 30, 0, 144, 142, 1, 0, 0, 0, 144, 145, 1,
+		0, 0, 0, 145, 282, 1, 0, 0, 0, 146, 147, 5, 150, 0, 0, 147, 148, 5, 26,
+		0, 0, 148, 149, 7, 0, 0, 0, 149, 282, 3, 82, 41, 0, 150, 151, 5, 150, 0,
+		0, 151, 152, 5, 31, 0, 0, 152, 153, 5, 157, 0, 0, 153, 282, 3, 82, 41,
+		0, 154, 155, 5, 150, 0, 0, 155, 156, 5, 31, 0, 0, 156, 157, 5, 180, 0,
+		0, 157, 282, 3, 82, 41, 0, 158, 159, 5, 44, 0, 0, 159, 282, 3, 82, 41,
+		0, 160, 161, 5, 43, 0, 0, 161, 282, 3, 82, 41, 0, 162, 163, 5, 150, 0,
+		0, 163, 164, 5, 120, 0, 0, 164, 165, 7, 0, 0, 0, 165, 168, 3, 82, 41, 0,
+		166, 167, 5, 182, 0, 0, 167, 169, 3, 50, 25, 0, 168, 166, 1, 0, 0, 0, 168,
+		169, 1, 0, 0, 0, 169, 180, 1, 0, 0, 0, 170, 171, 5, 114, 0, 0, 171, 172,
 		5, 17, 0, 0, 172, 177, 3, 22, 11, 0, 173, 174, 5, 2, 0, 0, 174, 176, 3,
 		22, 11, 0, 175, 173, 1, 0, 0, 0, 176, 179, 1, 0, 0, 0, 177, 175, 1, 0,
 		0, 0, 177, 178, 1, 0, 0, 0, 178, 181, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0,
 		180, 170, 1, 0, 0, 0, 180, 181, 1, 0, 0, 0, 181, 184, 1, 0, 0, 0, 182,
-		183, 5, 90, 0, 0, 183, 185, 7, 1, 0, 0, 184, 182, 1, 0, 0, 0, 184, 185,
-		1, 0, 0, 0, 185, 187, 1, 0, 0, 0, 186, 96, 1, 0, 0, 0, 186, 97, 1, 0, 0,
-		0, 186, 99, 1, 0, 0, 0, 186, 104, 1, 0, 0, 0, 186, 112, 1, 0, 0, 0, 186,
-		126, 1, 0, 0, 0, 186, 140, 1, 0, 0, 0, 186, 146, 1, 0, 0, 0, 186, 150,
-		1, 0, 0, 0, 186, 154, 1, 0, 0, 0, 186, 158, 1, 0, 0, 0, 186, 160, 1, 0,
-		0, 0, 186, 162, 1, 0, 0, 0, 187, 5, 1, 0, 0, 0, 188, 191, 3, 8, 4, 0, 189,
-		191, 3, 10, 5, 0, 190, 188, 1, 0, 0, 0, 190, 189, 1, 0, 0, 0, 191, 7, 1,
-		0, 0, 0, 192, 193, 3, 84, 42, 0, 193, 196, 3, 68, 34, 0, 194, 195, 5, 26,
-		0, 0, 195, 197, 3, 60, 30, 0, 196, 194, 1, 0, 0, 0, 196, 197, 1, 0, 0,
-		0, 197, 9, 1, 0, 0, 0, 198, 199, 5, 89, 0, 0, 199, 202, 3, 82, 41, 0, 200,
-		201, 7, 2, 0, 0, 201, 203, 5, 123, 0, 0, 202, 200, 1, 0, 0, 0, 202, 203,
-		1, 0, 0, 0, 203, 11, 1, 0, 0, 0, 204, 205, 5, 3, 0, 0, 205, 210, 3, 14,
-		7, 0, 206, 207, 5, 2, 0, 0, 207, 209, 3, 14, 7, 0, 208, 206, 1, 0, 0, 0,
-		209, 212, 1, 0, 0, 0, 210, 208, 1, 0, 0, 0, 210, 211, 1, 0, 0, 0, 211,
-		213, 1, 0, 0, 0, 212, 210, 1, 0, 0, 0, 213, 214, 5, 4, 0, 0, 214, 13, 1,
-		0, 0, 0, 215, 216, 3, 84, 42, 0, 216, 217, 5, 185, 0, 0, 217, 218, 3, 48,
-		24, 0, 218, 15, 1, 0, 0, 0, 219, 230, 3, 18, 9, 0, 220, 221, 5, 112, 0,
-		0, 221, 222, 5, 17, 0, 0, 222, 227, 3, 22, 11, 0, 223, 224, 5, 2, 0, 0,
-		224, 226, 3, 22, 11, 0, 225, 223, 1, 0, 0, 0, 226, 229, 1, 0, 0, 0, 227,
-		225, 1, 0, 0, 0, 227, 228, 1, 0, 0, 0, 228, 231, 1, 0, 0, 0, 229, 227,
-		1, 0, 0, 0, 230, 220, 1, 0, 0, 0, 230, 231, 1, 0, 0, 0, 231, 234, 1, 0,
-		0, 0, 232, 233, 5, 90, 0, 0, 233, 235, 7, 1, 0, 0, 234, 232, 1, 0, 0, 0,
-		234, 235, 1, 0, 0, 0, 235, 17, 1, 0, 0, 0, 236, 237, 6, 9, -1, 0, 237,
-		238, 3, 20, 10, 0, 238, 253, 1, 0, 0, 0, 239, 240, 10, 2, 0, 0, 240, 242,
-		5, 79, 0, 0, 241, 243, 3, 30, 15, 0, 242, 241, 1, 0, 0, 0, 242, 243, 1,
-		0, 0, 0, 243, 244, 1, 0, 0, 0, 244, 252, 3, 18, 9, 3, 245, 246, 10, 1,
-		0, 0, 246, 248, 7, 3, 0, 0, 247, 249, 3, 30, 15, 0, 248, 247, 1, 0, 0,
-		0, 248, 249, 1, 0, 0, 0, 249, 250, 1, 0, 0, 0, 250, 252, 3, 18, 9, 2, 251,
-		239, 1, 0, 0, 0, 251, 245, 1, 0, 0, 0, 252, 255, 1, 0, 0, 0, 253, 251,
-		1, 0, 0, 0, 253, 254, 1, 0, 0, 0, 254, 19, 1, 0, 0, 0, 255, 253, 1, 0,
-		0, 0, 256, 262, 3, 24, 12, 0, 257, 258, 5, 3, 0, 0, 258, 259, 3, 16, 8,
-		0, 259, 260, 5, 4, 0, 0, 260, 262, 1, 0, 0, 0, 261, 256, 1, 0, 0, 0, 261,
-		257, 1, 0, 0, 0, 262, 21, 1, 0, 0, 0, 263, 265, 3, 48, 24, 0, 264, 266,
-		7, 4, 0, 0, 265, 264, 1, 0, 0, 0, 265, 266, 1, 0, 0, 0, 266, 269, 1, 0,
-		0, 0, 267, 268, 5, 107, 0, 0, 268, 270, 7, 5, 0, 0, 269, 267, 1, 0, 0,
-		0, 269, 270, 1, 0, 0, 0, 270, 23, 1, 0, 0, 0, 271, 273, 5, 142, 0, 0, 272,
-		274, 3, 30, 15, 0, 273, 272, 1, 0, 0, 0, 273, 274, 1, 0, 0, 0, 274, 275,
-		1, 0, 0, 0, 275, 280, 3, 32, 16, 0, 276, 277, 5, 2, 0, 0, 277, 279, 3,
-		32, 16, 0, 278, 276, 1, 0, 0, 0, 279, 282, 1, 0, 0, 0, 280, 278, 1, 0,
-		0, 0, 280, 281, 1, 0, 0, 0, 281, 292, 1, 0, 0, 0, 282, 280, 1, 0, 0, 0,
-		283, 284, 5, 62, 0, 0, 284, 289, 3, 34, 17, 0, 285, 286, 5, 2, 0, 0, 286,
-		288, 3, 34, 17, 0, 287, 285, 1, 0, 0, 0, 288, 291, 1, 0, 0, 0, 289, 287,
-		1, 0, 0, 0, 289, 290, 1, 0, 0, 0, 290, 293, 1, 0, 0, 0, 291, 289, 1, 0,
-		0, 0, 292, 283, 1, 0, 0, 0, 292, 293, 1, 0, 0, 0, 293, 296, 1, 0, 0, 0,
-		294, 295, 5, 179, 0, 0, 295, 297, 3, 50, 25, 0, 296, 294, 1, 0, 0, 0, 296,
-		297, 1, 0, 0, 0, 297, 301, 1, 0, 0, 0, 298, 299, 5, 68, 0, 0, 299, 300,
-		5, 17, 0, 0, 300, 302, 3, 26, 13, 0, 301, 298, 1, 0, 0, 0, 301, 302, 1,
-		0, 0, 0, 302, 305, 1, 0, 0, 0, 303, 304, 5, 70, 0, 0, 304, 306, 3, 50,
-		25, 0, 305, 303, 1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 25, 1, 0, 0, 0,
-		307, 309, 3, 30, 15, 0, 308, 307, 1, 0, 0, 0, 308, 309, 1, 0, 0, 0, 309,
-		310, 1, 0, 0, 0, 310, 315, 3, 28, 14, 0, 311, 312, 5, 2, 0, 0, 312, 314,
-		3, 28, 14, 0, 313, 311, 1, 0, 0, 0, 314, 317, 1, 0, 0, 0, 315, 313, 1,
-		0, 0, 0, 315, 316, 1, 0, 0, 0, 316, 27, 1, 0, 0, 0, 317, 315, 1, 0, 0,
-		0, 318, 319, 3, 48, 24, 0, 319, 29, 1, 0, 0, 0, 320, 321, 7, 6, 0, 0, 321,
-		31, 1, 0, 0, 0, 322, 327, 3, 48, 24, 0, 323, 325, 5, 12, 0, 0, 324, 323,
-		1, 0, 0, 0, 324, 325, 1, 0, 0, 0, 325, 326, 1, 0, 0, 0, 326, 328, 3, 84,
-		42, 0, 327, 324, 1, 0, 0, 0, 327, 328, 1, 0, 0, 0, 328, 335, 1, 0, 0, 0,
-		329, 330, 3, 82, 41, 0, 330, 331, 5, 1, 0, 0, 331, 332, 5, 193, 0, 0, 332,
-		335, 1, 0, 0, 0, 333, 335, 5, 193, 0, 0, 334, 322, 1, 0, 0, 0, 334, 329,
-		1, 0, 0, 0, 334, 333, 1, 0, 0, 0, 335, 33, 1, 0, 0, 0, 336, 337, 6, 17,
-		-1, 0, 337, 338, 3, 42, 21, 0, 338, 347, 1, 0, 0, 0, 339, 340, 10, 2, 0,
-		0, 340, 341, 3, 36, 18, 0, 341, 342, 5, 84, 0, 0, 342, 343, 3, 34, 17,
-		0, 343, 344, 3, 38, 19, 0, 344, 346, 1, 0, 0, 0, 345, 339, 1, 0, 0, 0,
-		346, 349, 1, 0, 0, 0, 347, 345, 1, 0, 0, 0, 347, 348, 1, 0, 0, 0, 348,
-		35, 1, 0, 0, 0, 349, 347, 1, 0, 0, 0, 350, 352, 5, 75, 0, 0, 351, 350,
-		1, 0, 0, 0, 351, 352, 1, 0, 0, 0, 352, 366, 1, 0, 0, 0, 353, 355, 5, 87,
-		0, 0, 354, 356, 5, 114, 0, 0, 355, 354, 1, 0, 0, 0, 355, 356, 1, 0, 0,
-		0, 356, 366, 1, 0, 0, 0, 357, 359, 5, 134, 0, 0, 358, 360, 5, 114, 0, 0,
-		359, 358, 1, 0, 0, 0, 359, 360, 1, 0, 0, 0, 360, 366, 1, 0, 0, 0, 361,
-		363, 5, 63, 0, 0, 362, 364, 5, 114, 0, 0, 363, 362, 1, 0, 0, 0, 363, 364,
-		1, 0, 0, 0, 364, 366, 1, 0, 0, 0, 365, 351, 1, 0, 0, 0, 365, 353, 1, 0,
-		0, 0, 365, 357, 1, 0, 0, 0, 365, 361, 1, 0, 0, 0, 366, 37, 1, 0, 0, 0,
-		367, 368, 5, 108, 0, 0, 368, 382, 3, 50, 25, 0, 369, 370, 5, 173, 0, 0,
-		370, 371, 5, 3, 0, 0, 371, 376, 3, 84, 42, 0, 372, 373, 5, 2, 0, 0, 373,
-		375, 3, 84, 42, 0, 374, 372, 1, 0, 0, 0, 375, 378, 1, 0, 0, 0, 376, 374,
-		1, 0, 0, 0, 376, 377, 1, 0, 0, 0, 377, 379, 1, 0, 0, 0, 378, 376, 1, 0,
-		0, 0, 379, 380, 5, 4, 0, 0, 380, 382, 1, 0, 0, 0, 381, 367, 1, 0, 0, 0,
-		381, 369, 1, 0, 0, 0, 382, 39, 1, 0, 0, 0, 383, 384, 7, 7, 0, 0, 384, 41,
-		1, 0, 0, 0, 385, 390, 3, 46, 23, 0, 386, 388, 5, 12, 0, 0, 387, 386, 1,
-		0, 0, 0, 387, 388, 1, 0, 0, 0, 388, 389, 1, 0, 0, 0, 389, 391, 3, 84, 42,
-		0, 390, 387, 1, 0, 0, 0, 390, 391, 1, 0, 0, 0, 391, 43, 1, 0, 0, 0, 392,
-		393, 5, 3, 0, 0, 393, 398, 3, 84, 42, 0, 394, 395, 5, 2, 0, 0, 395, 397,
-		3, 84, 42, 0, 396, 394, 1, 0, 0, 0, 397, 400, 1, 0, 0, 0, 398, 396, 1,
-		0, 0, 0, 398, 399, 1, 0, 0, 0, 399, 401, 1, 0, 0, 0, 400, 398, 1, 0, 0,
-		0, 401, 402, 5, 4, 0, 0, 402, 45, 1, 0, 0, 0, 403, 413, 3, 82, 41, 0, 404,
-		405, 5, 3, 0, 0, 405, 406, 3, 16, 8, 0, 406, 407, 5, 4, 0, 0, 407, 413,
-		1, 0, 0, 0, 408, 409, 5, 3, 0, 0, 409, 410, 3, 34, 17, 0, 410, 411, 5,
-		4, 0, 0, 411, 413, 1, 0, 0, 0, 412, 403, 1, 0, 0, 0, 412, 404, 1, 0, 0,
-		0, 412, 408, 1, 0, 0, 0, 413, 47, 1, 0, 0, 0, 414, 415, 3, 50, 25, 0, 415,
-		49, 1, 0, 0, 0, 416, 417, 6, 25, -1, 0, 417, 421, 3, 52, 26, 0, 418, 419,
-		5, 104, 0, 0, 419, 421, 3, 50, 25, 3, 420, 416, 1, 0, 0, 0, 420, 418, 1,
-		0, 0, 0, 421, 430, 1, 0, 0, 0, 422, 423, 10, 2, 0, 0, 423, 424, 5, 9, 0,
-		0, 424, 429, 3, 50, 25, 3, 425, 426, 10, 1, 0, 0, 426, 427, 5, 111, 0,
-		0, 427, 429, 3, 50, 25, 2, 428, 422, 1, 0, 0, 0, 428, 425, 1, 0, 0, 0,
-		429, 432, 1, 0, 0, 0, 430, 428, 1, 0, 0, 0, 430, 431, 1, 0, 0, 0, 431,
-		51, 1, 0, 0, 0, 432, 430, 1, 0, 0, 0, 433, 435, 3, 56, 28, 0, 434, 436,
-		3, 54, 27, 0, 435, 434, 1, 0, 0, 0, 435, 436, 1, 0, 0, 0, 436, 53, 1, 0,
-		0, 0, 437, 438, 3, 62, 31, 0, 438, 439, 3, 56, 28, 0, 439, 499, 1, 0, 0,
-		0, 440, 441, 3, 62, 31, 0, 441, 442, 3, 64, 32, 0, 442, 443, 5, 3, 0, 0,
-		443, 444, 3, 16, 8, 0, 444, 445, 5, 4, 0, 0, 445, 499, 1, 0, 0, 0, 446,
-		448, 5, 104, 0, 0, 447, 446, 1, 0, 0, 0, 447, 448, 1, 0, 0, 0, 448, 449,
-		1, 0, 0, 0, 449, 450, 5, 16, 0, 0, 450, 451, 3, 56, 28, 0, 451, 452, 5,
-		9, 0, 0, 452, 453, 3, 56, 28, 0, 453, 499, 1, 0, 0, 0, 454, 456, 5, 104,
-		0, 0, 455, 454, 1, 0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 457, 1, 0, 0, 0,
-		457, 458, 5, 73, 0, 0, 458, 459, 5, 3, 0, 0, 459, 464, 3, 48, 24, 0, 460,
-		461, 5, 2, 0, 0, 461, 463, 3, 48, 24, 0, 462, 460, 1, 0, 0, 0, 463, 466,
-		1, 0, 0, 0, 464, 462, 1, 0, 0, 0, 464, 465, 1, 0, 0, 0, 465, 467, 1, 0,
-		0, 0, 466, 464, 1, 0, 0, 0, 467, 468, 5, 4, 0, 0, 468, 499, 1, 0, 0, 0,
-		469, 471, 5, 104, 0, 0, 470, 469, 1, 0, 0, 0, 470, 471, 1, 0, 0, 0, 471,
-		472, 1, 0, 0, 0, 472, 473, 5, 73, 0, 0, 473, 474, 5, 3, 0, 0, 474, 475,
-		3, 16, 8, 0, 475, 476, 5, 4, 0, 0, 476, 499, 1, 0, 0, 0, 477, 479, 5, 104,
-		0, 0, 478, 477, 1, 0, 0, 0, 478, 479, 1, 0, 0, 0, 479, 480, 1, 0, 0, 0,
-		480, 481, 5, 89, 0, 0, 481, 484, 3, 56, 28, 0, 482, 483, 5, 49, 0, 0, 483,
-		485, 3, 56, 28, 0, 484, 482, 1, 0, 0, 0, 484, 485, 1, 0, 0, 0, 485, 499,
-		1, 0, 0, 0, 486, 488, 5, 82, 0, 0, 487, 489, 5, 104, 0, 0, 488, 487, 1,
-		0, 0, 0, 488, 489, 1, 0, 0, 0, 489, 490, 1, 0, 0, 0, 490, 499, 5, 105,
-		0, 0, 491, 493, 5, 82, 0, 0, 492, 494, 5, 104, 0, 0, 493, 492, 1, 0, 0,
-		0, 493, 494, 1, 0, 0, 0, 494, 495, 1, 0, 0, 0, 495, 496, 5, 44, 0, 0, 496,
-		497, 5, 62, 0, 0, 497, 499, 3, 56, 28, 0, 498, 437, 1, 0, 0, 0, 498, 440,
-		1, 0, 0, 0, 498, 447, 1, 0, 0, 0, 498, 455, 1, 0, 0, 0, 498, 470, 1, 0,
-		0, 0, 498, 478, 1, 0, 0, 0, 498, 486, 1, 0, 0, 0, 498, 491, 1, 0, 0, 0,
-		499, 55, 1, 0, 0, 0, 500, 501, 6, 28, -1, 0, 501, 505, 3, 58, 29, 0, 502,
-		503, 7, 8, 0, 0, 503, 505, 3, 56, 28, 4, 504, 500, 1, 0, 0, 0, 504, 502,
-		1, 0, 0, 0, 505, 517, 1, 0, 0, 0, 506, 507, 10, 3, 0, 0, 507, 508, 7, 9,
-		0, 0, 508, 516, 3, 56, 28, 4, 509, 510, 10, 2, 0, 0, 510, 511, 7, 8, 0,
-		0, 511, 516, 3, 56, 28, 3, 512, 513, 10, 1, 0, 0, 513, 514, 5, 196, 0,
-		0, 514, 516, 3, 56, 28, 2, 515, 506, 1, 0, 0, 0, 515, 509, 1, 0, 0, 0,
-		515, 512, 1, 0, 0, 0, 516, 519, 1, 0, 0, 0, 517, 515, 1, 0, 0, 0, 517,
-		518, 1, 0, 0, 0, 518, 57, 1, 0, 0, 0, 519, 517, 1, 0, 0, 0, 520, 521, 6,
-		29, -1, 0, 521, 575, 5, 105, 0, 0, 522, 523, 3, 84, 42, 0, 523, 524, 3,
-		60, 30, 0, 524, 575, 1, 0, 0, 0, 525, 575, 3, 86, 43, 0, 526, 575, 3, 66,
-		33, 0, 527, 575, 3, 60, 30, 0, 528, 575, 3, 84, 42, 0, 529, 530, 3, 82,
-		41, 0, 530, 542, 5, 3, 0, 0, 531, 533, 3, 30, 15, 0, 532, 531, 1, 0, 0,
-		0, 532, 533, 1, 0, 0, 0, 533, 534, 1, 0, 0, 0, 534, 539, 3, 48, 24, 0,
-		535, 536, 5, 2, 0, 0, 536, 538, 3, 48, 24, 0, 537, 535, 1, 0, 0, 0, 538,
-		541, 1, 0, 0, 0, 539, 537, 1, 0, 0, 0, 539, 540, 1, 0, 0, 0, 540, 543,
-		1, 0, 0, 0, 541, 539, 1, 0, 0, 0, 542, 532, 1, 0, 0, 0, 542, 543, 1, 0,
-		0, 0, 543, 554, 1, 0, 0, 0, 544, 545, 5, 112, 0, 0, 545, 546, 5, 17, 0,
-		0, 546, 551, 3, 22, 11, 0, 547, 548, 5, 2, 0, 0, 548, 550, 3, 22, 11, 0,
-		549, 547, 1, 0, 0, 0, 550, 553, 1, 0, 0, 0, 551, 549, 1, 0, 0, 0, 551,
-		552, 1, 0, 0, 0, 552, 555, 1, 0, 0, 0, 553, 551, 1, 0, 0, 0, 554, 544,
-		1, 0, 0, 0, 554, 555, 1, 0, 0, 0, 555, 556, 1, 0, 0, 0, 556, 557, 5, 4,
-		0, 0, 557, 575, 1, 0, 0, 0, 558, 560, 5, 20, 0, 0, 559, 561, 3, 74, 37,
-		0, 560, 559, 1, 0, 0, 0, 561, 562, 1, 0, 0, 0, 562, 560, 1, 0, 0, 0, 562,
-		563, 1, 0, 0, 0, 563, 566, 1, 0, 0, 0, 564, 565, 5, 47, 0, 0, 565, 567,
-		3, 48, 24, 0, 566, 564, 1, 0, 0, 0, 566, 567, 1, 0, 0, 0, 567, 568, 1,
-		0, 0, 0, 568, 569, 5, 48, 0, 0, 569, 575, 1, 0, 0, 0, 570, 571, 5, 3, 0,
-		0, 571, 572, 3, 48, 24, 0, 572, 573, 5, 4, 0, 0, 573, 575, 1, 0, 0, 0,
-		574, 520, 1, 0, 0, 0, 574, 522, 1, 0, 0, 0, 574, 525, 1, 0, 0, 0, 574,
-		526, 1, 0, 0, 0, 574, 527, 1, 0, 0, 0, 574, 528, 1, 0, 0, 0, 574, 529,
-		1, 0, 0, 0, 574, 558, 1, 0, 0, 0, 574, 570, 1, 0, 0, 0, 575, 581, 1, 0,
-		0, 0, 576, 577, 10, 3, 0, 0, 577, 578, 5, 1, 0, 0, 578, 580, 3, 84, 42,
-		0, 579, 576, 1, 0, 0, 0, 580, 583, 1, 0, 0, 0, 581, 579, 1, 0, 0, 0, 581,
-		582, 1, 0, 0, 0, 582, 59, 1, 0, 0, 0, 583, 581, 1, 0, 0, 0, 584, 585, 5,
-		197, 0, 0, 585, 61, 1, 0, 0, 0, 586, 587, 7, 10, 0, 0, 587, 63, 1, 0, 0,
-		0, 588, 589, 7, 11, 0, 0, 589, 65, 1, 0, 0, 0, 590, 591, 7, 12, 0, 0, 591,
-		67, 1, 0, 0, 0, 592, 593, 6, 34, -1, 0, 593, 594, 5, 11, 0, 0, 594, 595,
-		5, 187, 0, 0, 595, 596, 3, 68, 34, 0, 596, 597, 5, 189, 0, 0, 597, 635,
-		1, 0, 0, 0, 598, 599, 5, 94, 0, 0, 599, 600, 5, 187, 0, 0, 600, 601, 3,
-		68, 34, 0, 601, 602, 5, 2, 0, 0, 602, 603, 3, 68, 34, 0, 603, 604, 5, 189,
-		0, 0, 604, 635, 1, 0, 0, 0, 605, 606, 5, 137, 0, 0, 606, 607, 5, 3, 0,
-		0, 607, 608, 3, 84, 42, 0, 608, 615, 3, 68, 34, 0, 609, 610, 5, 2, 0, 0,
-		610, 611, 3, 84, 42, 0, 611, 612, 3, 68, 34, 0, 612, 614, 1, 0, 0, 0, 613,
-		609, 1, 0, 0, 0, 614, 617, 1, 0, 0, 0, 615, 613, 1, 0, 0, 0, 615, 616,
-		1, 0, 0, 0, 616, 618, 1, 0, 0, 0, 617, 615, 1, 0, 0, 0, 618, 619, 5, 4,
-		0, 0, 619, 635, 1, 0, 0, 0, 620, 632, 3, 72, 36, 0, 621, 622, 5, 3, 0,
-		0, 622, 627, 3, 70, 35, 0, 623, 624, 5, 2, 0, 0, 624, 626, 3, 70, 35, 0,
-		625, 623, 1, 0, 0, 0, 626, 629, 1, 0, 0, 0, 627, 625, 1, 0, 0, 0, 627,
-		628, 1, 0, 0, 0, 628, 630, 1, 0, 0, 0, 629, 627, 1, 0, 0, 0, 630, 631,
-		5, 4, 0, 0, 631, 633, 1, 0, 0, 0, 632, 621, 1, 0, 0, 0, 632, 633, 1, 0,
-		0, 0, 633, 635, 1, 0, 0, 0, 634, 592, 1, 0, 0, 0, 634, 598, 1, 0, 0, 0,
-		634, 605, 1, 0, 0, 0, 634, 620, 1, 0, 0, 0, 635, 640, 1, 0, 0, 0, 636,
-		637, 10, 5, 0, 0, 637, 639, 5, 11, 0, 0, 638, 636, 1, 0, 0, 0, 639, 642,
-		1, 0, 0, 0, 640, 638, 1, 0, 0, 0, 640, 641, 1, 0, 0, 0, 641, 69, 1, 0,
-		0, 0, 642, 640, 1, 0, 0, 0, 643, 646, 5, 200, 0, 0, 644, 646, 3, 68, 34,
-		0, 645, 643, 1, 0, 0, 0, 645, 644, 1, 0, 0, 0, 646, 71, 1, 0, 0, 0, 647,
-		652, 5, 206, 0, 0, 648, 652, 5, 207, 0, 0, 649, 652, 5, 208, 0, 0, 650,
-		652, 3, 84, 42, 0, 651, 647, 1, 0, 0, 0, 651, 648, 1, 0, 0, 0, 651, 649,
-		1, 0, 0, 0, 651, 650, 1, 0, 0, 0, 652, 73, 1, 0, 0, 0, 653, 654, 5, 178,
-		0, 0, 654, 655, 3, 48, 24, 0, 655, 656, 5, 158, 0, 0, 656, 657, 3, 48,
-		24, 0, 657, 75, 1, 0, 0, 0, 658, 659, 5, 57, 0, 0, 659, 660, 5, 3, 0, 0,
-		660, 661, 5, 179, 0, 0, 661, 662, 3, 50, 25, 0, 662, 663, 5, 4, 0, 0, 663,
-		77, 1, 0, 0, 0, 664, 665, 5, 116, 0, 0, 665, 676, 5, 3, 0, 0, 666, 667,
-		5, 117, 0, 0, 667, 668, 5, 17, 0, 0, 668, 673, 3, 48, 24, 0, 669, 670,
-		5, 2, 0, 0, 670, 672, 3, 48, 24, 0, 671, 669, 1, 0, 0, 0, 672, 675, 1,
-		0, 0, 0, 673, 671, 1, 0, 0, 0, 673, 674, 1, 0, 0, 0, 674, 677, 1, 0, 0,
-		0, 675, 673, 1, 0, 0, 0, 676, 666, 1, 0, 0, 0, 676, 677, 1, 0, 0, 0, 677,
-		688, 1, 0, 0, 0, 678, 679, 5, 112, 0, 0, 679, 680, 5, 17, 0, 0, 680, 685,
-		3, 22, 11, 0, 681, 682, 5, 2, 0, 0, 682, 684, 3, 22, 11, 0, 683, 681, 1,
-		0, 0, 0, 684, 687, 1, 0, 0, 0, 685, 683, 1, 0, 0, 0, 685, 686, 1, 0, 0,
-		0, 686, 689, 1, 0, 0, 0, 687, 685, 1, 0, 0, 0, 688, 678, 1, 0, 0, 0, 688,
-		689, 1, 0, 0, 0, 689, 690, 1, 0, 0, 0, 690, 691, 5, 4, 0, 0, 691, 79, 1,
-		0, 0, 0, 692, 697, 5, 142, 0, 0, 693, 697, 5, 41, 0, 0, 694, 697, 5, 77,
-		0, 0, 695, 697, 3, 84, 42, 0, 696, 692, 1, 0, 0, 0, 696, 693, 1, 0, 0,
-		0, 696, 694, 1, 0, 0, 0, 696, 695, 1, 0, 0, 0, 697, 81, 1, 0, 0, 0, 698,
-		703, 3, 84, 42, 0, 699, 700, 5, 1, 0, 0, 700, 702, 3, 84, 42, 0, 701, 699,
-		1, 0, 0, 0, 702, 705, 1, 0, 0, 0, 703, 701, 1, 0, 0, 0, 703, 704, 1, 0,
-		0, 0, 704, 83, 1, 0, 0, 0, 705, 703, 1, 0, 0, 0, 706, 711, 5, 202, 0, 0,
-		707, 711, 5, 204, 0, 0, 708, 711, 3, 88, 44, 0, 709, 711, 5, 203, 0, 0,
-		710, 706, 1, 0, 0, 0, 710, 707, 1, 0, 0, 0, 710, 708, 1, 0, 0, 0, 710,
-		709, 1, 0, 0, 0, 711, 85, 1, 0, 0, 0, 712, 713, 7, 13, 0, 0, 713, 87, 1,
-		0, 0, 0, 714, 715, 7, 14, 0, 0, 715, 89, 1, 0, 0, 0, 90, 108, 116, 122,
-		124, 130, 136, 138, 144, 168, 177, 180, 184, 186, 190, 196, 202, 210, 227,
-		230, 234, 242, 248, 251, 253, 261, 265, 269, 273, 280, 289, 292, 296, 301,
-		305, 308, 315, 324, 327, 334, 347, 351, 355, 359, 363, 365, 376, 381, 387,
-		390, 398, 412, 420, 428, 430, 435, 447, 455, 464, 470, 478, 484, 488, 493,
-		498, 504, 515, 517, 532, 539, 542, 551, 554, 562, 566, 574, 581, 615, 627,
-		632, 634, 640, 645, 651, 673, 676, 685, 688, 696, 703, 710,
+		183, 5, 91, 0, 0, 183, 185, 7, 1, 0, 0, 184, 182, 1, 0, 0, 0, 184, 185,
+		1, 0, 0, 0, 185, 282, 1, 0, 0, 0, 186, 187, 5, 130, 0, 0, 187, 189, 5,
+		96, 0, 0, 188, 190, 3, 84, 42, 0, 189, 188, 1, 0, 0, 0, 189, 190, 1, 0,
+		0, 0, 190, 282, 1, 0, 0, 0, 191, 192, 5, 31, 0, 0, 192, 196, 5, 22, 0,
+		0, 193, 194, 5, 73, 0, 0, 194, 195, 5, 106, 0, 0, 195, 197, 5, 54, 0, 0,
+		196, 193, 1, 0, 0, 0, 196, 197, 1, 0, 0, 0, 197, 198, 1, 0, 0, 0, 198,
+		199, 3, 84, 42, 0, 199, 200, 5, 176, 0, 0, 200, 203, 3, 84, 42, 0, 201,
+		202, 5, 183, 0, 0, 202, 204, 3, 12, 6, 0, 203, 201, 1, 0, 0, 0, 203, 204,
+		1, 0, 0, 0, 204, 282, 1, 0, 0, 0, 205, 206, 5, 47, 0, 0, 206, 209, 5, 22,
+		0, 0, 207, 208, 5, 73, 0, 0, 208, 210, 5, 54, 0, 0, 209, 207, 1, 0, 0,
+		0, 209, 210, 1, 0, 0, 0, 210, 211, 1, 0, 0, 0, 211, 282, 3, 84, 42, 0,
+		212, 213, 5, 31, 0, 0, 213, 217, 5, 157, 0, 0, 214, 215, 5, 73, 0, 0, 215,
+		216, 5, 106, 0, 0, 216, 218, 5, 54, 0, 0, 217, 214, 1, 0, 0, 0, 217, 218,
+		1, 0, 0, 0, 218, 219, 1, 0, 0, 0, 219, 220, 3, 82, 41, 0, 220, 221, 5,
+		3, 0, 0, 221, 226, 3, 6, 3, 0, 222, 223, 5, 2, 0, 0, 223, 225, 3, 6, 3,
+		0, 224, 222, 1, 0, 0, 0, 225, 228, 1, 0, 0, 0, 226, 224, 1, 0, 0, 0, 226,
+		227, 1, 0, 0, 0, 227, 229, 1, 0, 0, 0, 228, 226, 1, 0, 0, 0, 229, 232,
+		5, 4, 0, 0, 230, 231, 5, 27, 0, 0, 231, 233, 3, 60, 30, 0, 232, 230, 1,
+		0, 0, 0, 232, 233, 1, 0, 0, 0, 233, 236, 1, 0, 0, 0, 234, 235, 5, 183,
+		0, 0, 235, 237, 3, 12, 6, 0, 236, 234, 1, 0, 0, 0, 236, 237, 1, 0, 0, 0,
+		237, 282, 1, 0, 0, 0, 238, 239, 5, 31, 0, 0, 239, 243, 5, 157, 0, 0, 240,
+		241, 5, 73, 0, 0, 241, 242, 5, 106, 0, 0, 242, 244, 5, 54, 0, 0, 243, 240,
+		1, 0, 0, 0, 243, 244, 1, 0, 0, 0, 244, 245, 1, 0, 0, 0, 245, 248, 3, 82,
+		41, 0, 246, 247, 5, 27, 0, 0, 247, 249, 3, 60, 30, 0, 248, 246, 1, 0, 0,
+		0, 248, 249, 1, 0, 0, 0, 249, 252, 1, 0, 0, 0, 250, 251, 5, 183, 0, 0,
+		251, 253, 3, 12, 6, 0, 252, 250, 1, 0, 0, 0, 252, 253, 1, 0, 0, 0, 253,
+		254, 1, 0, 0, 0, 254, 255, 5, 12, 0, 0, 255, 256, 3, 16, 8, 0, 256, 282,
+		1, 0, 0, 0, 257, 258, 5, 47, 0, 0, 258, 261, 5, 157, 0, 0, 259, 260, 5,
+		73, 0, 0, 260, 262, 5, 54, 0, 0, 261, 259, 1, 0, 0, 0, 261, 262, 1, 0,
+		0, 0, 262, 263, 1, 0, 0, 0, 263, 282, 3, 82, 41, 0, 264, 267, 5, 31, 0,
+		0, 265, 266, 5, 113, 0, 0, 266, 268, 5, 133, 0, 0, 267, 265, 1, 0, 0, 0,
+		267, 268, 1, 0, 0, 0, 268, 269, 1, 0, 0, 0, 269, 270, 5, 180, 0, 0, 270,
+		271, 3, 82, 41, 0, 271, 272, 5, 12, 0, 0, 272, 273, 3, 16, 8, 0, 273, 282,
+		1, 0, 0, 0, 274, 275, 5, 47, 0, 0, 275, 278, 5, 180, 0, 0, 276, 277, 5,
+		73, 0, 0, 277, 279, 5, 54, 0, 0, 278, 276, 1, 0, 0, 0, 278, 279, 1, 0,
+		0, 0, 279, 280, 1, 0, 0, 0, 280, 282, 3, 82, 41, 0, 281, 96, 1, 0, 0, 0,
+		281, 97, 1, 0, 0, 0, 281, 99, 1, 0, 0, 0, 281, 104, 1, 0, 0, 0, 281, 112,
+		1, 0, 0, 0, 281, 126, 1, 0, 0, 0, 281, 140, 1, 0, 0, 0, 281, 146, 1, 0,
+		0, 0, 281, 150, 1, 0, 0, 0, 281, 154, 1, 0, 0, 0, 281, 158, 1, 0, 0, 0,
+		281, 160, 1, 0, 0, 0, 281, 162, 1, 0, 0, 0, 281, 186, 1, 0, 0, 0, 281,
+		191, 1, 0, 0, 0, 281, 205, 1, 0, 0, 0, 281, 212, 1, 0, 0, 0, 281, 238,
+		1, 0, 0, 0, 281, 257, 1, 0, 0, 0, 281, 264, 1, 0, 0, 0, 281, 274, 1, 0,
+		0, 0, 282, 5, 1, 0, 0, 0, 283, 286, 3, 8, 4, 0, 284, 286, 3, 10, 5, 0,
+		285, 283, 1, 0, 0, 0, 285, 284, 1, 0, 0, 0, 286, 7, 1, 0, 0, 0, 287, 288,
+		3, 84, 42, 0, 288, 291, 3, 68, 34, 0, 289, 290, 5, 27, 0, 0, 290, 292,
+		3, 60, 30, 0, 291, 289, 1, 0, 0, 0, 291, 292, 1, 0, 0, 0, 292, 9, 1, 0,
+		0, 0, 293, 294, 5, 90, 0, 0, 294, 297, 3, 82, 41, 0, 295, 296, 7, 2, 0,
+		0, 296, 298, 5, 125, 0, 0, 297, 295, 1, 0, 0, 0, 297, 298, 1, 0, 0, 0,
+		298, 11, 1, 0, 0, 0, 299, 300, 5, 3, 0, 0, 300, 305, 3, 14, 7, 0, 301,
+		302, 5, 2, 0, 0, 302, 304, 3, 14, 7, 0, 303, 301, 1, 0, 0, 0, 304, 307,
+		1, 0, 0, 0, 305, 303, 1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 308, 1, 0,
+		0, 0, 307, 305, 1, 0, 0, 0, 308, 309, 5, 4, 0, 0, 309, 13, 1, 0, 0, 0,
+		310, 311, 3, 84, 42, 0, 311, 312, 5, 188, 0, 0, 312, 313, 3, 48, 24, 0,
+		313, 15, 1, 0, 0, 0, 314, 325, 3, 18, 9, 0, 315, 316, 5, 114, 0, 0, 316,
+		317, 5, 17, 0, 0, 317, 322, 3, 22, 11, 0, 318, 319, 5, 2, 0, 0, 319, 321,
+		3, 22, 11, 0, 320, 318, 1, 0, 0, 0, 321, 324, 1, 0, 0, 0, 322, 320, 1,
+		0, 0, 0, 322, 323, 1, 0, 0, 0, 323, 326, 1, 0, 0, 0, 324, 322, 1, 0, 0,
+		0, 325, 315, 1, 0, 0, 0, 325, 326, 1, 0, 0, 0, 326, 329, 1, 0, 0, 0, 327,
+		328, 5, 91, 0, 0, 328, 330, 7, 1, 0, 0, 329, 327, 1, 0, 0, 0, 329, 330,
+		1, 0, 0, 0, 330, 17, 1, 0, 0, 0, 331, 332, 6, 9, -1, 0, 332, 333, 3, 20,
+		10, 0, 333, 348, 1, 0, 0, 0, 334, 335, 10, 2, 0, 0, 335, 337, 5, 80, 0,
+		0, 336, 338, 3, 30, 15, 0, 337, 336, 1, 0, 0, 0, 337, 338, 1, 0, 0, 0,
+		338, 339, 1, 0, 0, 0, 339, 347, 3, 18, 9, 3, 340, 341, 10, 1, 0, 0, 341,
+		343, 7, 3, 0, 0, 342, 344, 3, 30, 15, 0, 343, 342, 1, 0, 0, 0, 343, 344,
+		1, 0, 0, 0, 344, 345, 1, 0, 0, 0, 345, 347, 3, 18, 9, 2, 346, 334, 1, 0,
+		0, 0, 346, 340, 1, 0, 0, 0, 347, 350, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0,
+		348, 349, 1, 0, 0, 0, 349, 19, 1, 0, 0, 0, 350, 348, 1, 0, 0, 0, 351, 357,
+		3, 24, 12, 0, 352, 353, 5, 3, 0, 0, 353, 354, 3, 16, 8, 0, 354, 355, 5,
+		4, 0, 0, 355, 357, 1, 0, 0, 0, 356, 351, 1, 0, 0, 0, 356, 352, 1, 0, 0,
+		0, 357, 21, 1, 0, 0, 0, 358, 360, 3, 48, 24, 0, 359, 361, 7, 4, 0, 0, 360,
+		359, 1, 0, 0, 0, 360, 361, 1, 0, 0, 0, 361, 364, 1, 0, 0, 0, 362, 363,
+		5, 109, 0, 0, 363, 365, 7, 5, 0, 0, 364, 362, 1, 0, 0, 0, 364, 365, 1,
+		0, 0, 0, 365, 23, 1, 0, 0, 0, 366, 368, 5, 145, 0, 0, 367, 369, 3, 30,
+		15, 0, 368, 367, 1, 0, 0, 0, 368, 369, 1, 0, 0, 0, 369, 370, 1, 0, 0, 0,
+		370, 375, 3, 32, 16, 0, 371, 372, 5, 2, 0, 0, 372, 374, 3, 32, 16, 0, 373,
+		371, 1, 0, 0, 0, 374, 377, 1, 0, 0, 0, 375, 373, 1, 0, 0, 0, 375, 376,
+		1, 0, 0, 0, 376, 387, 1, 0, 0, 0, 377, 375, 1, 0, 0, 0, 378, 379, 5, 63,
+		0, 0, 379, 384, 3, 34, 17, 0, 380, 381, 5, 2, 0, 0, 381, 383, 3, 34, 17,
+		0, 382, 380, 1, 0, 0, 0, 383, 386, 1, 0, 0, 0, 384, 382, 1, 0, 0, 0, 384,
+		385, 1, 0, 0, 0, 385, 388, 1, 0, 0, 0, 386, 384, 1, 0, 0, 0, 387, 378,
+		1, 0, 0, 0, 387, 388, 1, 0, 0, 0, 388, 391, 1, 0, 0, 0, 389, 390, 5, 182,
+		0, 0, 390, 392, 3, 50, 25, 0, 391, 389, 1, 0, 0, 0, 391, 392, 1, 0, 0,
+		0, 392, 396, 1, 0, 0, 0, 393, 394, 5, 69, 0, 0, 394, 395, 5, 17, 0, 0,
+		395, 397, 3, 26, 13, 0, 396, 393, 1, 0, 0, 0, 396, 397, 1, 0, 0, 0, 397,
+		400, 1, 0, 0, 0, 398, 399, 5, 71, 0, 0, 399, 401, 3, 50, 25, 0, 400, 398,
+		1, 0, 0, 0, 400, 401, 1, 0, 0, 0, 401, 25, 1, 0, 0, 0, 402, 404, 3, 30,
+		15, 0, 403, 402, 1, 0, 0, 0, 403, 404, 1, 0, 0, 0, 404, 405, 1, 0, 0, 0,
+		405, 410, 3, 28, 14, 0, 406, 407, 5, 2, 0, 0, 407, 409, 3, 28, 14, 0, 408,
+		406, 1, 0, 0, 0, 409, 412, 1, 0, 0, 0, 410, 408, 1, 0, 0, 0, 410, 411,
+		1, 0, 0, 0, 411, 27, 1, 0, 0, 0, 412, 410, 1, 0, 0, 0, 413, 414, 3, 48,
+		24, 0, 414, 29, 1, 0, 0, 0, 415, 416, 7, 6, 0, 0, 416, 31, 1, 0, 0, 0,
+		417, 422, 3, 48, 24, 0, 418, 420, 5, 12, 0, 0, 419, 418, 1, 0, 0, 0, 419,
+		420, 1, 0, 0, 0, 420, 421, 1, 0, 0, 0, 421, 423, 3, 84, 42, 0, 422, 419,
+		1, 0, 0, 0, 422, 423, 1, 0, 0, 0, 423, 430, 1, 0, 0, 0, 424, 425, 3, 82,
+		41, 0, 425, 426, 5, 1, 0, 0, 426, 427, 5, 196, 0, 0, 427, 430, 1, 0, 0,
+		0, 428, 430, 5, 196, 0, 0, 429, 417, 1, 0, 0, 0, 429, 424, 1, 0, 0, 0,
+		429, 428, 1, 0, 0, 0, 430, 33, 1, 0, 0, 0, 431, 432, 6, 17, -1, 0, 432,
+		433, 3, 42, 21, 0, 433, 442, 1, 0, 0, 0, 434, 435, 10, 2, 0, 0, 435, 436,
+		3, 36, 18, 0, 436, 437, 5, 85, 0, 0, 437, 438, 3, 34, 17, 0, 438, 439,
+		3, 38, 19, 0, 439, 441, 1, 0, 0, 0, 440, 434, 1, 0, 0, 0, 441, 444, 1,
+		0, 0, 0, 442, 440, 1, 0, 0, 0, 442, 443, 1, 0, 0, 0, 443, 35, 1, 0, 0,
+		0, 444, 442, 1, 0, 0, 0, 445, 447, 5, 76, 0, 0, 446, 445, 1, 0, 0, 0, 446,
+		447, 1, 0, 0, 0, 447, 461, 1, 0, 0, 0, 448, 450, 5, 88, 0, 0, 449, 451,
+		5, 116, 0, 0, 450, 449, 1, 0, 0, 0, 450, 451, 1, 0, 0, 0, 451, 461, 1,
+		0, 0, 0, 452, 454, 5, 137, 0, 0, 453, 455, 5, 116, 0, 0, 454, 453, 1, 0,
+		0, 0, 454, 455, 1, 0, 0, 0, 455, 461, 1, 0, 0, 0, 456, 458, 5, 64, 0, 0,
+		457, 459, 5, 116, 0, 0, 458, 457, 1, 0, 0, 0, 458, 459, 1, 0, 0, 0, 459,
+		461, 1, 0, 0, 0, 460, 446, 1, 0, 0, 0, 460, 448, 1, 0, 0, 0, 460, 452,
+		1, 0, 0, 0, 460, 456, 1, 0, 0, 0, 461, 37, 1, 0, 0, 0, 462, 463, 5, 110,
+		0, 0, 463, 477, 3, 50, 25, 0, 464, 465, 5, 176, 0, 0, 465, 466, 5, 3, 0,
+		0, 466, 471, 3, 84, 42, 0, 467, 468, 5, 2, 0, 0, 468, 470, 3, 84, 42, 0,
+		469, 467, 1, 0, 0, 0, 470, 473, 1, 0, 0, 0, 471, 469, 1, 0, 0, 0, 471,
+		472, 1, 0, 0, 0, 472, 474, 1, 0, 0, 0, 473, 471, 1, 0, 0, 0, 474, 475,
+		5, 4, 0, 0, 475, 477, 1, 0, 0, 0, 476, 462, 1, 0, 0, 0, 476, 464, 1, 0,
+		0, 0, 477, 39, 1, 0, 0, 0, 478, 479, 7, 7, 0, 0, 479, 41, 1, 0, 0, 0, 480,
+		485, 3, 46, 23, 0, 481, 483, 5, 12, 0, 0, 482, 481, 1, 0, 0, 0, 482, 483,
+		1, 0, 0, 0, 483, 484, 1, 0, 0, 0, 484, 486, 3, 84, 42, 0, 485, 482, 1,
+		0, 0, 0, 485, 486, 1, 0, 0, 0, 486, 43, 1, 0, 0, 0, 487, 488, 5, 3, 0,
+		0, 488, 493, 3, 84, 42, 0, 489, 490, 5, 2, 0, 0, 490, 492, 3, 84, 42, 0,
+		491, 489, 1, 0, 0, 0, 492, 495, 1, 0, 0, 0, 493, 491, 1, 0, 0, 0, 493,
+		494, 1, 0, 0, 0, 494, 496, 1, 0, 0, 0, 495, 493, 1, 0, 0, 0, 496, 497,
+		5, 4, 0, 0, 497, 45, 1, 0, 0, 0, 498, 508, 3, 82, 41, 0, 499, 500, 5, 3,
+		0, 0, 500, 501, 3, 16, 8, 0, 501, 502, 5, 4, 0, 0, 502, 508, 1, 0, 0, 0,
+		503, 504, 5, 3, 0, 0, 504, 505, 3, 34, 17, 0, 505, 506, 5, 4, 0, 0, 506,
+		508, 1, 0, 0, 0, 507, 498, 1, 0, 0, 0, 507, 499, 1, 0, 0, 0, 507, 503,
+		1, 0, 0, 0, 508, 47, 1, 0, 0, 0, 509, 510, 3, 50, 25, 0, 510, 49, 1, 0,
+		0, 0, 511, 512, 6, 25, -1, 0, 512, 516, 3, 52, 26, 0, 513, 514, 5, 106,
+		0, 0, 514, 516, 3, 50, 25, 3, 515, 511, 1, 0, 0, 0, 515, 513, 1, 0, 0,
+		0, 516, 525, 1, 0, 0, 0, 517, 518, 10, 2, 0, 0, 518, 519, 5, 9, 0, 0, 519,
+		524, 3, 50, 25, 3, 520, 521, 10, 1, 0, 0, 521, 522, 5, 113, 0, 0, 522,
+		524, 3, 50, 25, 2, 523, 517, 1, 0, 0, 0, 523, 520, 1, 0, 0, 0, 524, 527,
+		1, 0, 0, 0, 525, 523, 1, 0, 0, 0, 525, 526, 1, 0, 0, 0, 526, 51, 1, 0,
+		0, 0, 527, 525, 1, 0, 0, 0, 528, 530, 3, 56, 28, 0, 529, 531, 3, 54, 27,
+		0, 530, 529, 1, 0, 0, 0, 530, 531, 1, 0, 0, 0, 531, 53, 1, 0, 0, 0, 532,
+		533, 3, 62, 31, 0, 533, 534, 3, 56, 28, 0, 534, 594, 1, 0, 0, 0, 535, 536,
+		3, 62, 31, 0, 536, 537, 3, 64, 32, 0, 537, 538, 5, 3, 0, 0, 538, 539, 3,
+		16, 8, 0, 539, 540, 5, 4, 0, 0, 540, 594, 1, 0, 0, 0, 541, 543, 5, 106,
+		0, 0, 542, 541, 1, 0, 0, 0, 542, 543, 1, 0, 0, 0, 543, 544, 1, 0, 0, 0,
+		544, 545, 5, 16, 0, 0, 545, 546, 3, 56, 28, 0, 546, 547, 5, 9, 0, 0, 547,
+		548, 3, 56, 28, 0, 548, 594, 1, 0, 0, 0, 549, 551, 5, 106, 0, 0, 550, 549,
+		1, 0, 0, 0, 550, 551, 1, 0, 0, 0, 551, 552, 1, 0, 0, 0, 552, 553, 5, 74,
+		0, 0, 553, 554, 5, 3, 0, 0, 554, 559, 3, 48, 24, 0, 555, 556, 5, 2, 0,
+		0, 556, 558, 3, 48, 24, 0, 557, 555, 1, 0, 0, 0, 558, 561, 1, 0, 0, 0,
+		559, 557, 1, 0, 0, 0, 559, 560, 1, 0, 0, 0, 560, 562, 1, 0, 0, 0, 561,
+		559, 1, 0, 0, 0, 562, 563, 5, 4, 0, 0, 563, 594, 1, 0, 0, 0, 564, 566,
+		5, 106, 0, 0, 565, 564, 1, 0, 0, 0, 565, 566, 1, 0, 0, 0, 566, 567, 1,
+		0, 0, 0, 567, 568, 5, 74, 0, 0, 568, 569, 5, 3, 0, 0, 569, 570, 3, 16,
+		8, 0, 570, 571, 5, 4, 0, 0, 571, 594, 1, 0, 0, 0, 572, 574, 5, 106, 0,
+		0, 573, 572, 1, 0, 0, 0, 573, 574, 1, 0, 0, 0, 574, 575, 1, 0, 0, 0, 575,
+		576, 5, 90, 0, 0, 576, 579, 3, 56, 28, 0, 577, 578, 5, 50, 0, 0, 578, 580,
+		3, 56, 28, 0, 579, 577, 1, 0, 0, 0, 579, 580, 1, 0, 0, 0, 580, 594, 1,
+		0, 0, 0, 581, 583, 5, 83, 0, 0, 582, 584, 5, 106, 0, 0, 583, 582, 1, 0,
+		0, 0, 583, 584, 1, 0, 0, 0, 584, 585, 1, 0, 0, 0, 585, 594, 5, 107, 0,
+		0, 586, 588, 5, 83, 0, 0, 587, 589, 5, 106, 0, 0, 588, 587, 1, 0, 0, 0,
+		588, 589, 1, 0, 0, 0, 589, 590, 1, 0, 0, 0, 590, 591, 5, 45, 0, 0, 591,
+		592, 5, 63, 0, 0, 592, 594, 3, 56, 28, 0, 593, 532, 1, 0, 0, 0, 593, 535,
+		1, 0, 0, 0, 593, 542, 1, 0, 0, 0, 593, 550, 1, 0, 0, 0, 593, 565, 1, 0,
+		0, 0, 593, 573, 1, 0, 0, 0, 593, 581, 1, 0, 0, 0, 593, 586, 1, 0, 0, 0,
+		594, 55, 1, 0, 0, 0, 595, 596, 6, 28, -1, 0, 596, 600, 3, 58, 29, 0, 597,
+		598, 7, 8, 0, 0, 598, 600, 3, 56, 28, 4, 599, 595, 1, 0, 0, 0, 599, 597,
+		1, 0, 0, 0, 600, 612, 1, 0, 0, 0, 601, 602, 10, 3, 0, 0, 602, 603, 7, 9,
+		0, 0, 603, 611, 3, 56, 28, 4, 604, 605, 10, 2, 0, 0, 605, 606, 7, 8, 0,
+		0, 606, 611, 3, 56, 28, 3, 607, 608, 10, 1, 0, 0, 608, 609, 5, 199, 0,
+		0, 609, 611, 3, 56, 28, 2, 610, 601, 1, 0, 0, 0, 610, 604, 1, 0, 0, 0,
+		610, 607, 1, 0, 0, 0, 611, 614, 1, 0, 0, 0, 612, 610, 1, 0, 0, 0, 612,
+		613, 1, 0, 0, 0, 613, 57, 1, 0, 0, 0, 614, 612, 1, 0, 0, 0, 615, 616, 6,
+		29, -1, 0, 616, 670, 5, 107, 0, 0, 617, 618, 3, 84, 42, 0, 618, 619, 3,
+		60, 30, 0, 619, 670, 1, 0, 0, 0, 620, 670, 3, 86, 43, 0, 621, 670, 3, 66,
+		33, 0, 622, 670, 3, 60, 30, 0, 623, 670, 3, 84, 42, 0, 624, 625, 3, 82,
+		41, 0, 625, 637, 5, 3, 0, 0, 626, 628, 3, 30, 15, 0, 627, 626, 1, 0, 0,
+		0, 627, 628, 1, 0, 0, 0, 628, 629, 1, 0, 0, 0, 629, 634, 3, 48, 24, 0,
+		630, 631, 5, 2, 0, 0, 631, 633, 3, 48, 24, 0, 632, 630, 1, 0, 0, 0, 633,
+		636, 1, 0, 0, 0, 634, 632, 1, 0, 0, 0, 634, 635, 1, 0, 0, 0, 635, 638,
+		1, 0, 0, 0, 636, 634, 1, 0, 0, 0, 637, 627, 1, 0, 0, 0, 637, 638, 1, 0,
+		0, 0, 638, 649, 1, 0, 0, 0, 639, 640, 5, 114, 0, 0, 640, 641, 5, 17, 0,
+		0, 641, 646, 3, 22, 11, 0, 642, 643, 5, 2, 0, 0, 643, 645, 3, 22, 11, 0,
+		644, 642, 1, 0, 0, 0, 645, 648, 1, 0, 0, 0, 646, 644, 1, 0, 0, 0, 646,
+		647, 1, 0, 0, 0, 647, 650, 1, 0, 0, 0, 648, 646, 1, 0, 0, 0, 649, 639,
+		1, 0, 0, 0, 649, 650, 1, 0, 0, 0, 650, 651, 1, 0, 0, 0, 651, 652, 5, 4,
+		0, 0, 652, 670, 1, 0, 0, 0, 653, 655, 5, 20, 0, 0, 654, 656, 3, 74, 37,
+		0, 655, 654, 1, 0, 0, 0, 656, 657, 1, 0, 0, 0, 657, 655, 1, 0, 0, 0, 657,
+		658, 1, 0, 0, 0, 658, 661, 1, 0, 0, 0, 659, 660, 5, 48, 0, 0, 660, 662,
+		3, 48, 24, 0, 661, 659, 1, 0, 0, 0, 661, 662, 1, 0, 0, 0, 662, 663, 1,
+		0, 0, 0, 663, 664, 5, 49, 0, 0, 664, 670, 1, 0, 0, 0, 665, 666, 5, 3, 0,
+		0, 666, 667, 3, 48, 24, 0, 667, 668, 5, 4, 0, 0, 668, 670, 1, 0, 0, 0,
+		669, 615, 1, 0, 0, 0, 669, 617, 1, 0, 0, 0, 669, 620, 1, 0, 0, 0, 669,
+		621, 1, 0, 0, 0, 669, 622, 1, 0, 0, 0, 669, 623, 1, 0, 0, 0, 669, 624,
+		1, 0, 0, 0, 669, 653, 1, 0, 0, 0, 669, 665, 1, 0, 0, 0, 670, 676, 1, 0,
+		0, 0, 671, 672, 10, 3, 0, 0, 672, 673, 5, 1, 0, 0, 673, 675, 3, 84, 42,
+		0, 674, 671, 1, 0, 0, 0, 675, 678, 1, 0, 0, 0, 676, 674, 1, 0, 0, 0, 676,
+		677, 1, 0, 0, 0, 677, 59, 1, 0, 0, 0, 678, 676, 1, 0, 0, 0, 679, 680, 5,
+		200, 0, 0, 680, 61, 1, 0, 0, 0, 681, 682, 7, 10, 0, 0, 682, 63, 1, 0, 0,
+		0, 683, 684, 7, 11, 0, 0, 684, 65, 1, 0, 0, 0, 685, 686, 7, 12, 0, 0, 686,
+		67, 1, 0, 0, 0, 687, 688, 6, 34, -1, 0, 688, 689, 5, 11, 0, 0, 689, 690,
+		5, 190, 0, 0, 690, 691, 3, 68, 34, 0, 691, 692, 5, 192, 0, 0, 692, 730,
+		1, 0, 0, 0, 693, 694, 5, 95, 0, 0, 694, 695, 5, 190, 0, 0, 695, 696, 3,
+		68, 34, 0, 696, 697, 5, 2, 0, 0, 697, 698, 3, 68, 34, 0, 698, 699, 5, 192,
+		0, 0, 699, 730, 1, 0, 0, 0, 700, 701, 5, 140, 0, 0, 701, 702, 5, 3, 0,
+		0, 702, 703, 3, 84, 42, 0, 703, 710, 3, 68, 34, 0, 704, 705, 5, 2, 0, 0,
+		705, 706, 3, 84, 42, 0, 706, 707, 3, 68, 34, 0, 707, 709, 1, 0, 0, 0, 708,
+		704, 1, 0, 0, 0, 709, 712, 1, 0, 0, 0, 710, 708, 1, 0, 0, 0, 710, 711,
+		1, 0, 0, 0, 711, 713, 1, 0, 0, 0, 712, 710, 1, 0, 0, 0, 713, 714, 5, 4,
+		0, 0, 714, 730, 1, 0, 0, 0, 715, 727, 3, 72, 36, 0, 716, 717, 5, 3, 0,
+		0, 717, 722, 3, 70, 35, 0, 718, 719, 5, 2, 0, 0, 719, 721, 3, 70, 35, 0,
+		720, 718, 1, 0, 0, 0, 721, 724, 1, 0, 0, 0, 722, 720, 1, 0, 0, 0, 722,
+		723, 1, 0, 0, 0, 723, 725, 1, 0, 0, 0, 724, 722, 1, 0, 0, 0, 725, 726,
+		5, 4, 0, 0, 726, 728, 1, 0, 0, 0, 727, 716, 1, 0, 0, 0, 727, 728, 1, 0,
+		0, 0, 728, 730, 1, 0, 0, 0, 729, 687, 1, 0, 0, 0, 729, 693, 1, 0, 0, 0,
+		729, 700, 1, 0, 0, 0, 729, 715, 1, 0, 0, 0, 730, 735, 1, 0, 0, 0, 731,
+		732, 10, 5, 0, 0, 732, 734, 5, 11, 0, 0, 733, 731, 1, 0, 0, 0, 734, 737,
+		1, 0, 0, 0, 735, 733, 1, 0, 0, 0, 735, 736, 1, 0, 0, 0, 736, 69, 1, 0,
+		0, 0, 737, 735, 1, 0, 0, 0, 738, 741, 5, 203, 0, 0, 739, 741, 3, 68, 34,
+		0, 740, 738, 1, 0, 0, 0, 740, 739, 1, 0, 0, 0, 741, 71, 1, 0, 0, 0, 742,
+		747, 5, 209, 0, 0, 743, 747, 5, 210, 0, 0, 744, 747, 5, 211, 0, 0, 745,
+		747, 3, 84, 42, 0, 746, 742, 1, 0, 0, 0, 746, 743, 1, 0, 0, 0, 746, 744,
+		1, 0, 0, 0, 746, 745, 1, 0, 0, 0, 747, 73, 1, 0, 0, 0, 748, 749, 5, 181,
+		0, 0, 749, 750, 3, 48, 24, 0, 750, 751, 5, 161, 0, 0, 751, 752, 3, 48,
+		24, 0, 752, 75, 1, 0, 0, 0, 753, 754, 5, 58, 0, 0, 754, 755, 5, 3, 0, 0,
+		755, 756, 5, 182, 0, 0, 756, 757, 3, 50, 25, 0, 757, 758, 5, 4, 0, 0, 758,
+		77, 1, 0, 0, 0, 759, 760, 5, 118, 0, 0, 760, 771, 5, 3, 0, 0, 761, 762,
+		5, 119, 0, 0, 762, 763, 5, 17, 0, 0, 763, 768, 3, 48, 24, 0, 764, 765,
+		5, 2, 0, 0, 765, 767, 3, 48, 24, 0, 766, 764, 1, 0, 0, 0, 767, 770, 1,
+		0, 0, 0, 768, 766, 1, 0, 0, 0, 768, 769, 1, 0, 0, 0, 769, 772, 1, 0, 0,
+		0, 770, 768, 1, 0, 0, 0, 771, 761, 1, 0, 0, 0, 771, 772, 1, 0, 0, 0, 772,
+		783, 1, 0, 0, 0, 773, 774, 5, 114, 0, 0, 774, 775, 5, 17, 0, 0, 775, 780,
+		3, 22, 11, 0, 776, 777, 5, 2, 0, 0, 777, 779, 3, 22, 11, 0, 778, 776, 1,
+		0, 0, 0, 779, 782, 1, 0, 0, 0, 780, 778, 1, 0, 0, 0, 780, 781, 1, 0, 0,
+		0, 781, 784, 1, 0, 0, 0, 782, 780, 1, 0, 0, 0, 783, 773, 1, 0, 0, 0, 783,
+		784, 1, 0, 0, 0, 784, 785, 1, 0, 0, 0, 785, 786, 5, 4, 0, 0, 786, 79, 1,
+		0, 0, 0, 787, 792, 5, 145, 0, 0, 788, 792, 5, 42, 0, 0, 789, 792, 5, 78,
+		0, 0, 790, 792, 3, 84, 42, 0, 791, 787, 1, 0, 0, 0, 791, 788, 1, 0, 0,
+		0, 791, 789, 1, 0, 0, 0, 791, 790, 1, 0, 0, 0, 792, 81, 1, 0, 0, 0, 793,
+		798, 3, 84, 42, 0, 794, 795, 5, 1, 0, 0, 795, 797, 3, 84, 42, 0, 796, 794,
+		1, 0, 0, 0, 797, 800, 1, 0, 0, 0, 798, 796, 1, 0, 0, 0, 798, 799, 1, 0,
+		0, 0, 799, 83, 1, 0, 0, 0, 800, 798, 1, 0, 0, 0, 801, 806, 5, 205, 0, 0,
+		802, 806, 5, 207, 0, 0, 803, 806, 3, 88, 44, 0, 804, 806, 5, 206, 0, 0,
+		805, 801, 1, 0, 0, 0, 805, 802, 1, 0, 0, 0, 805, 803, 1, 0, 0, 0, 805,
+		804, 1, 0, 0, 0, 806, 85, 1, 0, 0, 0, 807, 808, 7, 13, 0, 0, 808, 87, 1,
+		0, 0, 0, 809, 810, 7, 14, 0, 0, 810, 89, 1, 0, 0, 0, 104, 108, 116, 122,
+		124, 130, 136, 138, 144, 168, 177, 180, 184, 189, 196, 203, 209, 217, 226,
+		232, 236, 243, 248, 252, 261, 267, 278, 281, 285, 291, 297, 305, 322, 325,
+		329, 337, 343, 346, 348, 356, 360, 364, 368, 375, 384, 387, 391, 396, 400,
+		403, 410, 419, 422, 429, 442, 446, 450, 454, 458, 460, 471, 476, 482, 485,
+		493, 507, 515, 523, 525, 530, 542, 550, 559, 565, 573, 579, 583, 588, 593,
+		599, 610, 612, 627, 634, 637, 646, 649, 657, 661, 669, 676, 710, 722, 727,
+		729, 735, 740, 746, 768, 771, 780, 783, 791, 798, 805,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -512,198 +560,201 @@ const (
 	SqlParserCASCADE                  = 19
 	SqlParserCASE                     = 20
 	SqlParserCAST                     = 21
-	SqlParserCATALOGS                 = 22
-	SqlParserCOALESCE                 = 23
-	SqlParserCOLUMN                   = 24
-	SqlParserCOLUMNS                  = 25
-	SqlParserCOMMENT                  = 26
-	SqlParserCOMMIT                   = 27
-	SqlParserCOMMITTED                = 28
-	SqlParserCONSTRAINT               = 29
-	SqlParserCREATE                   = 30
-	SqlParserCROSS                    = 31
-	SqlParserCUBE                     = 32
-	SqlParserCURRENT                  = 33
-	SqlParserCURRENT_DATE             = 34
-	SqlParserCURRENT_TIME             = 35
-	SqlParserCURRENT_TIMESTAMP        = 36
-	SqlParserDATA                     = 37
-	SqlParserDATE                     = 38
-	SqlParserDAY                      = 39
-	SqlParserDEALLOCATE               = 40
-	SqlParserDELETE                   = 41
-	SqlParserDESC                     = 42
-	SqlParserDESCRIBE                 = 43
-	SqlParserDISTINCT                 = 44
-	SqlParserDISTRIBUTED              = 45
-	SqlParserDROP                     = 46
-	SqlParserELSE                     = 47
-	SqlParserEND                      = 48
-	SqlParserESCAPE                   = 49
-	SqlParserEXCEPT                   = 50
-	SqlParserEXCLUDING                = 51
-	SqlParserEXECUTE                  = 52
-	SqlParserEXISTS                   = 53
-	SqlParserEXPLAIN                  = 54
-	SqlParserEXTRACT                  = 55
-	SqlParserFALSE                    = 56
-	SqlParserFILTER                   = 57
-	SqlParserFIRST                    = 58
-	SqlParserFOLLOWING                = 59
-	SqlParserFOR                      = 60
-	SqlParserFORMAT                   = 61
-	SqlParserFROM                     = 62
-	SqlParserFULL                     = 63
-	SqlParserFUNCTIONS                = 64
-	SqlParserGRANT                    = 65
-	SqlParserGRANTS                   = 66
-	SqlParserGRAPHVIZ                 = 67
-	SqlParserGROUP                    = 68
-	SqlParserGROUPING                 = 69
-	SqlParserHAVING                   = 70
-	SqlParserHOUR                     = 71
-	SqlParserIF                       = 72
-	SqlParserIN                       = 73
-	SqlParserINCLUDING                = 74
-	SqlParserINNER                    = 75
-	SqlParserINPUT                    = 76
-	SqlParserINSERT                   = 77
-	SqlParserINTEGER                  = 78
-	SqlParserINTERSECT                = 79
-	SqlParserINTERVAL                 = 80
-	SqlParserINTO                     = 81
-	SqlParserIS                       = 82
-	SqlParserISOLATION                = 83
-	SqlParserJOIN                     = 84
-	SqlParserLAST                     = 85
-	SqlParserLATERAL                  = 86
-	SqlParserLEFT                     = 87
-	SqlParserLEVEL                    = 88
-	SqlParserLIKE                     = 89
-	SqlParserLIMIT                    = 90
-	SqlParserLOCALTIME                = 91
-	SqlParserLOCALTIMESTAMP           = 92
-	SqlParserLOGICAL                  = 93
-	SqlParserMAP                      = 94
-	SqlParserMINUTE                   = 95
-	SqlParserMONTH                    = 96
-	SqlParserNATURAL                  = 97
-	SqlParserNFC                      = 98
-	SqlParserNFD                      = 99
-	SqlParserNFKC                     = 100
-	SqlParserNFKD                     = 101
-	SqlParserNO                       = 102
-	SqlParserNORMALIZE                = 103
-	SqlParserNOT                      = 104
-	SqlParserNULL                     = 105
-	SqlParserNULLIF                   = 106
-	SqlParserNULLS                    = 107
-	SqlParserON                       = 108
-	SqlParserONLY                     = 109
-	SqlParserOPTION                   = 110
-	SqlParserOR                       = 111
-	SqlParserORDER                    = 112
-	SqlParserORDINALITY               = 113
-	SqlParserOUTER                    = 114
-	SqlParserOUTPUT                   = 115
-	SqlParserOVER                     = 116
-	SqlParserPARTITION                = 117
-	SqlParserPARTITIONS               = 118
-	SqlParserPOSITION                 = 119
-	SqlParserPRECEDING                = 120
-	SqlParserPREPARE                  = 121
-	SqlParserPRIVILEGES               = 122
-	SqlParserPROPERTIES               = 123
-	SqlParserPUBLIC                   = 124
-	SqlParserRANGE                    = 125
-	SqlParserREAD                     = 126
-	SqlParserRECURSIVE                = 127
-	SqlParserRENAME                   = 128
-	SqlParserREPEATABLE               = 129
-	SqlParserREPLACE                  = 130
-	SqlParserRESET                    = 131
-	SqlParserRESTRICT                 = 132
-	SqlParserREVOKE                   = 133
-	SqlParserRIGHT                    = 134
-	SqlParserROLLBACK                 = 135
-	SqlParserROLLUP                   = 136
-	SqlParserROW                      = 137
-	SqlParserROWS                     = 138
-	SqlParserSCHEMA                   = 139
-	SqlParserSCHEMAS                  = 140
-	SqlParserSECOND                   = 141
-	SqlParserSELECT                   = 142
-	SqlParserSERIALIZABLE             = 143
-	SqlParserSESSION                  = 144
-	SqlParserSET                      = 145
-	SqlParserSETS                     = 146
-	SqlParserSHOW                     = 147
-	SqlParserSMALLINT                 = 148
-	SqlParserSOME                     = 149
-	SqlParserSTART                    = 150
-	SqlParserSTATS                    = 151
-	SqlParserSUBSTRING                = 152
-	SqlParserSYSTEM                   = 153
-	SqlParserTABLE                    = 154
-	SqlParserTABLES                   = 155
-	SqlParserTABLESAMPLE              = 156
-	SqlParserTEXT                     = 157
-	SqlParserTHEN                     = 158
-	SqlParserTIME                     = 159
-	SqlParserTIMESTAMP                = 160
-	SqlParserTINYINT                  = 161
-	SqlParserTO                       = 162
-	SqlParserTRANSACTION              = 163
-	SqlParserTRUE                     = 164
-	SqlParserTRY_CAST                 = 165
-	SqlParserTYPE                     = 166
-	SqlParserUESCAPE                  = 167
-	SqlParserUNBOUNDED                = 168
-	SqlParserUNCOMMITTED              = 169
-	SqlParserUNION                    = 170
-	SqlParserUNNEST                   = 171
-	SqlParserUSE                      = 172
-	SqlParserUSING                    = 173
-	SqlParserVALIDATE                 = 174
-	SqlParserVALUES                   = 175
-	SqlParserVERBOSE                  = 176
-	SqlParserVIEW                     = 177
-	SqlParserWHEN                     = 178
-	SqlParserWHERE                    = 179
-	SqlParserWITH                     = 180
-	SqlParserWORK                     = 181
-	SqlParserWRITE                    = 182
-	SqlParserYEAR                     = 183
-	SqlParserZONE                     = 184
-	SqlParserEQ                       = 185
-	SqlParserNEQ                      = 186
-	SqlParserLT                       = 187
-	SqlParserLTE                      = 188
-	SqlParserGT                       = 189
-	SqlParserGTE                      = 190
-	SqlParserPLUS                     = 191
-	SqlParserMINUS                    = 192
-	SqlParserASTERISK                 = 193
-	SqlParserSLASH                    = 194
-	SqlParserPERCENT                  = 195
-	SqlParserCONCAT                   = 196
-	SqlParserSTRING                   = 197
-	SqlParserUNICODE_STRING           = 198
-	SqlParserBINARY_LITERAL           = 199
-	SqlParserINTEGER_VALUE            = 200
-	SqlParserDOUBLE_VALUE             = 201
-	SqlParserIDENTIFIER               = 202
-	SqlParserDIGIT_IDENTIFIER         = 203
-	SqlParserQUOTED_IDENTIFIER        = 204
-	SqlParserBACKQUOTED_IDENTIFIER    = 205
-	SqlParserTIME_WITH_TIME_ZONE      = 206
-	SqlParserTIMESTAMP_WITH_TIME_ZONE = 207
-	SqlParserDOUBLE_PRECISION         = 208
-	SqlParserSIMPLE_COMMENT           = 209
-	SqlParserBRACKETED_COMMENT        = 210
-	SqlParserWS                       = 211
-	SqlParserUNRECOGNIZED             = 212
-	SqlParserDELIMITER                = 213
+	SqlParserCATALOG                  = 22
+	SqlParserCATALOGS                 = 23
+	SqlParserCOALESCE                 = 24
+	SqlParserCOLUMN                   = 25
+	SqlParserCOLUMNS                  = 26
+	SqlParserCOMMENT                  = 27
+	SqlParserCOMMIT                   = 28
+	SqlParserCOMMITTED                = 29
+	SqlParserCONSTRAINT               = 30
+	SqlParserCREATE                   = 31
+	SqlParserCROSS                    = 32
+	SqlParserCUBE                     = 33
+	SqlParserCURRENT                  = 34
+	SqlParserCURRENT_DATE             = 35
+	SqlParserCURRENT_TIME             = 36
+	SqlParserCURRENT_TIMESTAMP        = 37
+	SqlParserDATA                     = 38
+	SqlParserDATE                     = 39
+	SqlParserDAY                      = 40
+	SqlParserDEALLOCATE               = 41
+	SqlParserDELETE                   = 42
+	SqlParserDESC                     = 43
+	SqlParserDESCRIBE                 = 44
+	SqlParserDISTINCT                 = 45
+	SqlParserDISTRIBUTED              = 46
+	SqlParserDROP                     = 47
+	SqlParserELSE                     = 48
+	SqlParserEND                      = 49
+	SqlParserESCAPE                   = 50
+	SqlParserEXCEPT                   = 51
+	SqlParserEXCLUDING                = 52
+	SqlParserEXECUTE                  = 53
+	SqlParserEXISTS                   = 54
+	SqlParserEXPLAIN                  = 55
+	SqlParserEXTRACT                  = 56
+	SqlParserFALSE                    = 57
+	SqlParserFILTER                   = 58
+	SqlParserFIRST                    = 59
+	SqlParserFOLLOWING                = 60
+	SqlParserFOR                      = 61
+	SqlParserFORMAT                   = 62
+	SqlParserFROM                     = 63
+	SqlParserFULL                     = 64
+	SqlParserFUNCTIONS                = 65
+	SqlParserGRANT                    = 66
+	SqlParserGRANTS                   = 67
+	SqlParserGRAPHVIZ                 = 68
+	SqlParserGROUP                    = 69
+	SqlParserGROUPING                 = 70
+	SqlParserHAVING                   = 71
+	SqlParserHOUR                     = 72
+	SqlParserIF                       = 73
+	SqlParserIN                       = 74
+	SqlParserINCLUDING                = 75
+	SqlParserINNER                    = 76
+	SqlParserINPUT                    = 77
+	SqlParserINSERT                   = 78
+	SqlParserINTEGER                  = 79
+	SqlParserINTERSECT                = 80
+	SqlParserINTERVAL                 = 81
+	SqlParserINTO                     = 82
+	SqlParserIS                       = 83
+	SqlParserISOLATION                = 84
+	SqlParserJOIN                     = 85
+	SqlParserLAST                     = 86
+	SqlParserLATERAL                  = 87
+	SqlParserLEFT                     = 88
+	SqlParserLEVEL                    = 89
+	SqlParserLIKE                     = 90
+	SqlParserLIMIT                    = 91
+	SqlParserLOCALTIME                = 92
+	SqlParserLOCALTIMESTAMP           = 93
+	SqlParserLOGICAL                  = 94
+	SqlParserMAP                      = 95
+	SqlParserMETADATA                 = 96
+	SqlParserMINUTE                   = 97
+	SqlParserMONTH                    = 98
+	SqlParserNATURAL                  = 99
+	SqlParserNFC                      = 100
+	SqlParserNFD                      = 101
+	SqlParserNFKC                     = 102
+	SqlParserNFKD                     = 103
+	SqlParserNO                       = 104
+	SqlParserNORMALIZE                = 105
+	SqlParserNOT                      = 106
+	SqlParserNULL                     = 107
+	SqlParserNULLIF                   = 108
+	SqlParserNULLS                    = 109
+	SqlParserON                       = 110
+	SqlParserONLY                     = 111
+	SqlParserOPTION                   = 112
+	SqlParserOR                       = 113
+	SqlParserORDER                    = 114
+	SqlParserORDINALITY               = 115
+	SqlParserOUTER                    = 116
+	SqlParserOUTPUT                   = 117
+	SqlParserOVER                     = 118
+	SqlParserPARTITION                = 119
+	SqlParserPARTITIONS               = 120
+	SqlParserPOSITION                 = 121
+	SqlParserPRECEDING                = 122
+	SqlParserPREPARE                  = 123
+	SqlParserPRIVILEGES               = 124
+	SqlParserPROPERTIES               = 125
+	SqlParserPUBLIC                   = 126
+	SqlParserRANGE                    = 127
+	SqlParserREAD                     = 128
+	SqlParserRECURSIVE                = 129
+	SqlParserREFRESH                  = 130
+	SqlParserRENAME                   = 131
+	SqlParserREPEATABLE               = 132
+	SqlParserREPLACE                  = 133
+	SqlParserRESET                    = 134
+	SqlParserRESTRICT                 = 135
+	SqlParserREVOKE                   = 136
+	SqlParserRIGHT                    = 137
+	SqlParserROLLBACK                 = 138
+	SqlParserROLLUP                   = 139
+	SqlParserROW                      = 140
+	SqlParserROWS                     = 141
+	SqlParserSCHEMA                   = 142
+	SqlParserSCHEMAS                  = 143
+	SqlParserSECOND                   = 144
+	SqlParserSELECT                   = 145
+	SqlParserSERIALIZABLE             = 146
+	SqlParserSESSION                  = 147
+	SqlParserSET                      = 148
+	SqlParserSETS                     = 149
+	SqlParserSHOW                     = 150
+	SqlParserSMALLINT                 = 151
+	SqlParserSOME                     = 152
+	SqlParserSTART                    = 153
+	SqlParserSTATS                    = 154
+	SqlParserSUBSTRING                = 155
+	SqlParserSYSTEM                   = 156
+	SqlParserTABLE                    = 157
+	SqlParserTABLES                   = 158
+	SqlParserTABLESAMPLE              = 159
+	SqlParserTEXT                     = 160
+	SqlParserTHEN                     = 161
+	SqlParserTIME                     = 162
+	SqlParserTIMESTAMP                = 163
+	SqlParserTINYINT                  = 164
+	SqlParserTO                       = 165
+	SqlParserTRANSACTION              = 166
+	SqlParserTRUE                     = 167
+	SqlParserTRY_CAST                 = 168
+	SqlParserTYPE                     = 169
+	SqlParserUESCAPE                  = 170
+	SqlParserUNBOUNDED                = 171
+	SqlParserUNCOMMITTED              = 172
+	SqlParserUNION                    = 173
+	SqlParserUNNEST                   = 174
+	SqlParserUSE                      = 175
+	SqlParserUSING                    = 176
+	SqlParserVALIDATE                 = 177
+	SqlParserVALUES                   = 178
+	SqlParserVERBOSE                  = 179
+	SqlParserVIEW                     = 180
+	SqlParserWHEN                     = 181
+	SqlParserWHERE                    = 182
+	SqlParserWITH                     = 183
+	SqlParserWORK                     = 184
+	SqlParserWRITE                    = 185
+	SqlParserYEAR                     = 186
+	SqlParserZONE                     = 187
+	SqlParserEQ                       = 188
+	SqlParserNEQ                      = 189
+	SqlParserLT                       = 190
+	SqlParserLTE                      = 191
+	SqlParserGT                       = 192
+	SqlParserGTE                      = 193
+	SqlParserPLUS                     = 194
+	SqlParserMINUS                    = 195
+	SqlParserASTERISK                 = 196
+	SqlParserSLASH                    = 197
+	SqlParserPERCENT                  = 198
+	SqlParserCONCAT                   = 199
+	SqlParserSTRING                   = 200
+	SqlParserUNICODE_STRING           = 201
+	SqlParserBINARY_LITERAL           = 202
+	SqlParserINTEGER_VALUE            = 203
+	SqlParserDOUBLE_VALUE             = 204
+	SqlParserIDENTIFIER               = 205
+	SqlParserDIGIT_IDENTIFIER         = 206
+	SqlParserQUOTED_IDENTIFIER        = 207
+	SqlParserBACKQUOTED_IDENTIFIER    = 208
+	SqlParserTIME_WITH_TIME_ZONE      = 209
+	SqlParserTIMESTAMP_WITH_TIME_ZONE = 210
+	SqlParserDOUBLE_PRECISION         = 211
+	SqlParserSIMPLE_COMMENT           = 212
+	SqlParserBRACKETED_COMMENT        = 213
+	SqlParserWS                       = 214
+	SqlParserUNRECOGNIZED             = 215
+	SqlParserDELIMITER                = 216
 )
 
 // SqlParser rules.
@@ -1019,6 +1070,12 @@ type IStatementContext interface {
 	// GetWhere returns the where rule contexts.
 	GetWhere() IBooleanExpressionContext
 
+	// GetConnectorType returns the connectorType rule contexts.
+	GetConnectorType() IIdentifierContext
+
+	// GetComment returns the comment rule contexts.
+	GetComment() IStringValueContext
+
 	// SetSchema sets the schema rule contexts.
 	SetSchema(IIdentifierContext)
 
@@ -1034,6 +1091,12 @@ type IStatementContext interface {
 	// SetWhere sets the where rule contexts.
 	SetWhere(IBooleanExpressionContext)
 
+	// SetConnectorType sets the connectorType rule contexts.
+	SetConnectorType(IIdentifierContext)
+
+	// SetComment sets the comment rule contexts.
+	SetComment(IStringValueContext)
+
 	// Getter signatures
 	Query() IQueryContext
 	USE() antlr.TerminalNode
@@ -1069,6 +1132,22 @@ type IStatementContext interface {
 	BooleanExpression() IBooleanExpressionContext
 	INTEGER_VALUE() antlr.TerminalNode
 	ALL() antlr.TerminalNode
+	REFRESH() antlr.TerminalNode
+	METADATA() antlr.TerminalNode
+	CATALOG() antlr.TerminalNode
+	USING() antlr.TerminalNode
+	IF() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	EXISTS() antlr.TerminalNode
+	WITH() antlr.TerminalNode
+	Properties() IPropertiesContext
+	DROP() antlr.TerminalNode
+	AllTableElement() []ITableElementContext
+	TableElement(i int) ITableElementContext
+	COMMENT() antlr.TerminalNode
+	AS() antlr.TerminalNode
+	OR() antlr.TerminalNode
+	REPLACE() antlr.TerminalNode
 
 	// IsStatementContext differentiates from other interfaces.
 	IsStatementContext()
@@ -1076,13 +1155,15 @@ type IStatementContext interface {
 
 type StatementContext struct {
 	*antlr.BaseParserRuleContext
-	parser  antlr.Parser
-	schema  IIdentifierContext
-	catalog IIdentifierContext
-	pattern IStringValueContext
-	escape  IStringValueContext
-	where   IBooleanExpressionContext
-	limit   antlr.Token
+	parser        antlr.Parser
+	schema        IIdentifierContext
+	catalog       IIdentifierContext
+	pattern       IStringValueContext
+	escape        IStringValueContext
+	where         IBooleanExpressionContext
+	limit         antlr.Token
+	connectorType IIdentifierContext
+	comment       IStringValueContext
 }
 
 func NewEmptyStatementContext() *StatementContext {
@@ -1121,6 +1202,10 @@ func (s *StatementContext) GetEscape() IStringValueContext { return s.escape }
 
 func (s *StatementContext) GetWhere() IBooleanExpressionContext { return s.where }
 
+func (s *StatementContext) GetConnectorType() IIdentifierContext { return s.connectorType }
+
+func (s *StatementContext) GetComment() IStringValueContext { return s.comment }
+
 func (s *StatementContext) SetSchema(v IIdentifierContext) { s.schema = v }
 
 func (s *StatementContext) SetCatalog(v IIdentifierContext) { s.catalog = v }
@@ -1131,6 +1216,10 @@ func (s *StatementContext) SetEscape(v IStringValueContext) { s.escape = v }
 
 func (s *StatementContext) SetWhere(v IBooleanExpressionContext) { s.where = v }
 
+func (s *StatementContext) SetConnectorType(v IIdentifierContext) { s.connectorType = v }
+
+func (s *StatementContext) SetComment(v IStringValueContext) { s.comment = v }
+
 func (s *StatementContext) Query() IQueryContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1414,6 +1503,115 @@ func (s *StatementContext) ALL() antlr.TerminalNode {
 	return s.GetToken(SqlParserALL, 0)
 }
 
+func (s *StatementContext) REFRESH() antlr.TerminalNode {
+	return s.GetToken(SqlParserREFRESH, 0)
+}
+
+func (s *StatementContext) METADATA() antlr.TerminalNode {
+	return s.GetToken(SqlParserMETADATA, 0)
+}
+
+func (s *StatementContext) CATALOG() antlr.TerminalNode {
+	return s.GetToken(SqlParserCATALOG, 0)
+}
+
+func (s *StatementContext) USING() antlr.TerminalNode {
+	return s.GetToken(SqlParserUSING, 0)
+}
+
+func (s *StatementContext) IF() antlr.TerminalNode {
+	return s.GetToken(SqlParserIF, 0)
+}
+
+func (s *StatementContext) NOT() antlr.TerminalNode {
+	return s.GetToken(SqlParserNOT, 0)
+}
+
+func (s *StatementContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(SqlParserEXISTS, 0)
+}
+
+func (s *StatementContext) WITH() antlr.TerminalNode {
+	return s.GetToken(SqlParserWITH, 0)
+}
+
+func (s *StatementContext) Properties() IPropertiesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPropertiesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPropertiesContext)
+}
+
+func (s *StatementContext) DROP() antlr.TerminalNode {
+	return s.GetToken(SqlParserDROP, 0)
+}
+
+func (s *StatementContext) AllTableElement() []ITableElementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITableElementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITableElementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITableElementContext); ok {
+			tst[i] = t.(ITableElementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StatementContext) TableElement(i int) ITableElementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITableElementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITableElementContext)
+}
+
+func (s *StatementContext) COMMENT() antlr.TerminalNode {
+	return s.GetToken(SqlParserCOMMENT, 0)
+}
+
+func (s *StatementContext) AS() antlr.TerminalNode {
+	return s.GetToken(SqlParserAS, 0)
+}
+
+func (s *StatementContext) OR() antlr.TerminalNode {
+	return s.GetToken(SqlParserOR, 0)
+}
+
+func (s *StatementContext) REPLACE() antlr.TerminalNode {
+	return s.GetToken(SqlParserREPLACE, 0)
+}
+
 func (s *StatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1458,9 +1656,9 @@ func (p *SqlParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(186)
+	p.SetState(281)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -1899,6 +2097,391 @@ func (p *SqlParser) Statement() (localctx IStatementContext) {
 
 		}
 
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(186)
+			p.Match(SqlParserREFRESH)
+		}
+		{
+			p.SetState(187)
+			p.Match(SqlParserMETADATA)
+		}
+		p.SetState(189)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&6669912155368516960) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&-291369499474963057) != 0) || ((int64((_la-130)) & ^0x3f) == 0 && ((int64(1)<<(_la-130))&272088306223644031) != 0) || ((int64((_la-205)) & ^0x3f) == 0 && ((int64(1)<<(_la-205))&7) != 0) {
+			{
+				p.SetState(188)
+
+				var _x = p.Identifier()
+
+				localctx.(*StatementContext).catalog = _x
+			}
+
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(191)
+			p.Match(SqlParserCREATE)
+		}
+		{
+			p.SetState(192)
+			p.Match(SqlParserCATALOG)
+		}
+		p.SetState(196)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(193)
+				p.Match(SqlParserIF)
+			}
+			{
+				p.SetState(194)
+				p.Match(SqlParserNOT)
+			}
+			{
+				p.SetState(195)
+				p.Match(SqlParserEXISTS)
+			}
+
+		}
+		{
+			p.SetState(198)
+
+			var _x = p.Identifier()
+
+			localctx.(*StatementContext).catalog = _x
+		}
+		{
+			p.SetState(199)
+			p.Match(SqlParserUSING)
+		}
+		{
+			p.SetState(200)
+
+			var _x = p.Identifier()
+
+			localctx.(*StatementContext).connectorType = _x
+		}
+		p.SetState(203)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SqlParserWITH {
+			{
+				p.SetState(201)
+				p.Match(SqlParserWITH)
+			}
+			{
+				p.SetState(202)
+				p.Properties()
+			}
+
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(205)
+			p.Match(SqlParserDROP)
+		}
+		{
+			p.SetState(206)
+			p.Match(SqlParserCATALOG)
+		}
+		p.SetState(209)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(207)
+				p.Match(SqlParserIF)
+			}
+			{
+				p.SetState(208)
+				p.Match(SqlParserEXISTS)
+			}
+
+		}
+		{
+			p.SetState(211)
+
+			var _x = p.Identifier()
+
+			localctx.(*StatementContext).catalog = _x
+		}
+
+	case 17:
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(212)
+			p.Match(SqlParserCREATE)
+		}
+		{
+			p.SetState(213)
+			p.Match(SqlParserTABLE)
+		}
+		p.SetState(217)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(214)
+				p.Match(SqlParserIF)
+			}
+			{
+				p.SetState(215)
+				p.Match(SqlParserNOT)
+			}
+			{
+				p.SetState(216)
+				p.Match(SqlParserEXISTS)
+			}
+
+		}
+		{
+			p.SetState(219)
+			p.QualifiedName()
+		}
+		{
+			p.SetState(220)
+			p.Match(SqlParserT__2)
+		}
+		{
+			p.SetState(221)
+			p.TableElement()
+		}
+		p.SetState(226)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SqlParserT__1 {
+			{
+				p.SetState(222)
+				p.Match(SqlParserT__1)
+			}
+			{
+				p.SetState(223)
+				p.TableElement()
+			}
+
+			p.SetState(228)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(229)
+			p.Match(SqlParserT__3)
+		}
+		p.SetState(232)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SqlParserCOMMENT {
+			{
+				p.SetState(230)
+				p.Match(SqlParserCOMMENT)
+			}
+			{
+				p.SetState(231)
+
+				var _x = p.StringValue()
+
+				localctx.(*StatementContext).comment = _x
+			}
+
+		}
+		p.SetState(236)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SqlParserWITH {
+			{
+				p.SetState(234)
+				p.Match(SqlParserWITH)
+			}
+			{
+				p.SetState(235)
+				p.Properties()
+			}
+
+		}
+
+	case 18:
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(238)
+			p.Match(SqlParserCREATE)
+		}
+		{
+			p.SetState(239)
+			p.Match(SqlParserTABLE)
+		}
+		p.SetState(243)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(240)
+				p.Match(SqlParserIF)
+			}
+			{
+				p.SetState(241)
+				p.Match(SqlParserNOT)
+			}
+			{
+				p.SetState(242)
+				p.Match(SqlParserEXISTS)
+			}
+
+		}
+		{
+			p.SetState(245)
+			p.QualifiedName()
+		}
+		p.SetState(248)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SqlParserCOMMENT {
+			{
+				p.SetState(246)
+				p.Match(SqlParserCOMMENT)
+			}
+			{
+				p.SetState(247)
+
+				var _x = p.StringValue()
+
+				localctx.(*StatementContext).comment = _x
+			}
+
+		}
+		p.SetState(252)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SqlParserWITH {
+			{
+				p.SetState(250)
+				p.Match(SqlParserWITH)
+			}
+			{
+				p.SetState(251)
+				p.Properties()
+			}
+
+		}
+		{
+			p.SetState(254)
+			p.Match(SqlParserAS)
+		}
+		{
+			p.SetState(255)
+			p.Query()
+		}
+
+	case 19:
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(257)
+			p.Match(SqlParserDROP)
+		}
+		{
+			p.SetState(258)
+			p.Match(SqlParserTABLE)
+		}
+		p.SetState(261)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(259)
+				p.Match(SqlParserIF)
+			}
+			{
+				p.SetState(260)
+				p.Match(SqlParserEXISTS)
+			}
+
+		}
+		{
+			p.SetState(263)
+			p.QualifiedName()
+		}
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(264)
+			p.Match(SqlParserCREATE)
+		}
+		p.SetState(267)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SqlParserOR {
+			{
+				p.SetState(265)
+				p.Match(SqlParserOR)
+			}
+			{
+				p.SetState(266)
+				p.Match(SqlParserREPLACE)
+			}
+
+		}
+		{
+			p.SetState(269)
+			p.Match(SqlParserVIEW)
+		}
+		{
+			p.SetState(270)
+			p.QualifiedName()
+		}
+		{
+			p.SetState(271)
+			p.Match(SqlParserAS)
+		}
+		{
+			p.SetState(272)
+			p.Query()
+		}
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(274)
+			p.Match(SqlParserDROP)
+		}
+		{
+			p.SetState(275)
+			p.Match(SqlParserVIEW)
+		}
+		p.SetState(278)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(276)
+				p.Match(SqlParserIF)
+			}
+			{
+				p.SetState(277)
+				p.Match(SqlParserEXISTS)
+			}
+
+		}
+		{
+			p.SetState(280)
+			p.QualifiedName()
+		}
+
 	}
 
 	return localctx
@@ -2021,21 +2604,21 @@ func (p *SqlParser) TableElement() (localctx ITableElementContext) {
 		}
 	}()
 
-	p.SetState(190)
+	p.SetState(285)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
+	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(188)
+			p.SetState(283)
 			p.ColumnDefinition()
 		}
 
 	case SqlParserLIKE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(189)
+			p.SetState(284)
 			p.LikeClause()
 		}
 
@@ -2188,24 +2771,24 @@ func (p *SqlParser) ColumnDefinition() (localctx IColumnDefinitionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(192)
+		p.SetState(287)
 		p.Identifier()
 	}
 	{
-		p.SetState(193)
+		p.SetState(288)
 		p.typeSql(0)
 	}
-	p.SetState(196)
+	p.SetState(291)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserCOMMENT {
 		{
-			p.SetState(194)
+			p.SetState(289)
 			p.Match(SqlParserCOMMENT)
 		}
 		{
-			p.SetState(195)
+			p.SetState(290)
 			p.StringValue()
 		}
 
@@ -2348,20 +2931,20 @@ func (p *SqlParser) LikeClause() (localctx ILikeClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(198)
+		p.SetState(293)
 		p.Match(SqlParserLIKE)
 	}
 	{
-		p.SetState(199)
+		p.SetState(294)
 		p.QualifiedName()
 	}
-	p.SetState(202)
+	p.SetState(297)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserEXCLUDING || _la == SqlParserINCLUDING {
 		{
-			p.SetState(200)
+			p.SetState(295)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -2379,7 +2962,7 @@ func (p *SqlParser) LikeClause() (localctx ILikeClauseContext) {
 			}
 		}
 		{
-			p.SetState(201)
+			p.SetState(296)
 			p.Match(SqlParserPROPERTIES)
 		}
 
@@ -2517,33 +3100,33 @@ func (p *SqlParser) Properties() (localctx IPropertiesContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(204)
+		p.SetState(299)
 		p.Match(SqlParserT__2)
 	}
 	{
-		p.SetState(205)
+		p.SetState(300)
 		p.Property()
 	}
-	p.SetState(210)
+	p.SetState(305)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SqlParserT__1 {
 		{
-			p.SetState(206)
+			p.SetState(301)
 			p.Match(SqlParserT__1)
 		}
 		{
-			p.SetState(207)
+			p.SetState(302)
 			p.Property()
 		}
 
-		p.SetState(212)
+		p.SetState(307)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(213)
+		p.SetState(308)
 		p.Match(SqlParserT__3)
 	}
 
@@ -2674,15 +3257,15 @@ func (p *SqlParser) Property() (localctx IPropertyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(215)
+		p.SetState(310)
 		p.Identifier()
 	}
 	{
-		p.SetState(216)
+		p.SetState(311)
 		p.Match(SqlParserEQ)
 	}
 	{
-		p.SetState(217)
+		p.SetState(312)
 		p.Expression()
 	}
 
@@ -2871,57 +3454,57 @@ func (p *SqlParser) Query() (localctx IQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(219)
+		p.SetState(314)
 		p.queryTerm(0)
 	}
-	p.SetState(230)
+	p.SetState(325)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserORDER {
 		{
-			p.SetState(220)
+			p.SetState(315)
 			p.Match(SqlParserORDER)
 		}
 		{
-			p.SetState(221)
+			p.SetState(316)
 			p.Match(SqlParserBY)
 		}
 		{
-			p.SetState(222)
+			p.SetState(317)
 			p.SortItem()
 		}
-		p.SetState(227)
+		p.SetState(322)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SqlParserT__1 {
 			{
-				p.SetState(223)
+				p.SetState(318)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(224)
+				p.SetState(319)
 				p.SortItem()
 			}
 
-			p.SetState(229)
+			p.SetState(324)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
-	p.SetState(234)
+	p.SetState(329)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserLIMIT {
 		{
-			p.SetState(232)
+			p.SetState(327)
 			p.Match(SqlParserLIMIT)
 		}
 		{
-			p.SetState(233)
+			p.SetState(328)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -3166,14 +3749,14 @@ func (p *SqlParser) queryTerm(_p int) (localctx IQueryTermContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(237)
+		p.SetState(332)
 		p.QueryPrimary()
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(253)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -3181,38 +3764,38 @@ func (p *SqlParser) queryTerm(_p int) (localctx IQueryTermContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(251)
+			p.SetState(346)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewQueryTermContext(p, _parentctx, _parentState)
 				localctx.(*QueryTermContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_queryTerm)
-				p.SetState(239)
+				p.SetState(334)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(240)
+					p.SetState(335)
 
 					var _m = p.Match(SqlParserINTERSECT)
 
 					localctx.(*QueryTermContext).operator = _m
 				}
-				p.SetState(242)
+				p.SetState(337)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == SqlParserALL || _la == SqlParserDISTINCT {
 					{
-						p.SetState(241)
+						p.SetState(336)
 						p.SetQuantifier()
 					}
 
 				}
 				{
-					p.SetState(244)
+					p.SetState(339)
 
 					var _x = p.queryTerm(3)
 
@@ -3223,13 +3806,13 @@ func (p *SqlParser) queryTerm(_p int) (localctx IQueryTermContext) {
 				localctx = NewQueryTermContext(p, _parentctx, _parentState)
 				localctx.(*QueryTermContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_queryTerm)
-				p.SetState(245)
+				p.SetState(340)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(246)
+					p.SetState(341)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -3246,19 +3829,19 @@ func (p *SqlParser) queryTerm(_p int) (localctx IQueryTermContext) {
 						p.Consume()
 					}
 				}
-				p.SetState(248)
+				p.SetState(343)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == SqlParserALL || _la == SqlParserDISTINCT {
 					{
-						p.SetState(247)
+						p.SetState(342)
 						p.SetQuantifier()
 					}
 
 				}
 				{
-					p.SetState(250)
+					p.SetState(345)
 
 					var _x = p.queryTerm(2)
 
@@ -3268,9 +3851,9 @@ func (p *SqlParser) queryTerm(_p int) (localctx IQueryTermContext) {
 			}
 
 		}
-		p.SetState(255)
+		p.SetState(350)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -3393,29 +3976,29 @@ func (p *SqlParser) QueryPrimary() (localctx IQueryPrimaryContext) {
 		}
 	}()
 
-	p.SetState(261)
+	p.SetState(356)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserSELECT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(256)
+			p.SetState(351)
 			p.QuerySpecification()
 		}
 
 	case SqlParserT__2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(257)
+			p.SetState(352)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(258)
+			p.SetState(353)
 			p.Query()
 		}
 		{
-			p.SetState(259)
+			p.SetState(354)
 			p.Match(SqlParserT__3)
 		}
 
@@ -3576,16 +4159,16 @@ func (p *SqlParser) SortItem() (localctx ISortItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(263)
+		p.SetState(358)
 		p.Expression()
 	}
-	p.SetState(265)
+	p.SetState(360)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserASC || _la == SqlParserDESC {
 		{
-			p.SetState(264)
+			p.SetState(359)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -3604,17 +4187,17 @@ func (p *SqlParser) SortItem() (localctx ISortItemContext) {
 		}
 
 	}
-	p.SetState(269)
+	p.SetState(364)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserNULLS {
 		{
-			p.SetState(267)
+			p.SetState(362)
 			p.Match(SqlParserNULLS)
 		}
 		{
-			p.SetState(268)
+			p.SetState(363)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -3939,87 +4522,87 @@ func (p *SqlParser) QuerySpecification() (localctx IQuerySpecificationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(271)
+		p.SetState(366)
 		p.Match(SqlParserSELECT)
 	}
-	p.SetState(273)
+	p.SetState(368)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(272)
+			p.SetState(367)
 			p.SetQuantifier()
 		}
 
 	}
 	{
-		p.SetState(275)
+		p.SetState(370)
 		p.SelectItem()
 	}
-	p.SetState(280)
+	p.SetState(375)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(276)
+				p.SetState(371)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(277)
+				p.SetState(372)
 				p.SelectItem()
 			}
 
 		}
-		p.SetState(282)
+		p.SetState(377)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
 	}
-	p.SetState(292)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(283)
+			p.SetState(378)
 			p.Match(SqlParserFROM)
 		}
 		{
-			p.SetState(284)
+			p.SetState(379)
 			p.relation(0)
 		}
-		p.SetState(289)
+		p.SetState(384)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(285)
+					p.SetState(380)
 					p.Match(SqlParserT__1)
 				}
 				{
-					p.SetState(286)
+					p.SetState(381)
 					p.relation(0)
 				}
 
 			}
-			p.SetState(291)
+			p.SetState(386)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext())
 		}
 
 	}
-	p.SetState(296)
+	p.SetState(391)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(294)
+			p.SetState(389)
 			p.Match(SqlParserWHERE)
 		}
 		{
-			p.SetState(295)
+			p.SetState(390)
 
 			var _x = p.booleanExpression(0)
 
@@ -4027,34 +4610,34 @@ func (p *SqlParser) QuerySpecification() (localctx IQuerySpecificationContext) {
 		}
 
 	}
-	p.SetState(301)
+	p.SetState(396)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(298)
+			p.SetState(393)
 			p.Match(SqlParserGROUP)
 		}
 		{
-			p.SetState(299)
+			p.SetState(394)
 			p.Match(SqlParserBY)
 		}
 		{
-			p.SetState(300)
+			p.SetState(395)
 			p.GroupBy()
 		}
 
 	}
-	p.SetState(305)
+	p.SetState(400)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(303)
+			p.SetState(398)
 			p.Match(SqlParserHAVING)
 		}
 		{
-			p.SetState(304)
+			p.SetState(399)
 
 			var _x = p.booleanExpression(0)
 
@@ -4212,39 +4795,39 @@ func (p *SqlParser) GroupBy() (localctx IGroupByContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(308)
+	p.SetState(403)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(307)
+			p.SetState(402)
 			p.SetQuantifier()
 		}
 
 	}
 	{
-		p.SetState(310)
+		p.SetState(405)
 		p.GroupingElement()
 	}
-	p.SetState(315)
+	p.SetState(410)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(311)
+				p.SetState(406)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(312)
+				p.SetState(407)
 				p.GroupingElement()
 			}
 
 		}
-		p.SetState(317)
+		p.SetState(412)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -4352,7 +4935,7 @@ func (p *SqlParser) GroupingElement() (localctx IGroupingElementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(318)
+		p.SetState(413)
 		p.Expression()
 	}
 
@@ -4455,7 +5038,7 @@ func (p *SqlParser) SetQuantifier() (localctx ISetQuantifierContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(320)
+		p.SetState(415)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SqlParserALL || _la == SqlParserDISTINCT) {
@@ -4614,32 +5197,32 @@ func (p *SqlParser) SelectItem() (localctx ISelectItemContext) {
 		}
 	}()
 
-	p.SetState(334)
+	p.SetState(429)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(322)
+			p.SetState(417)
 			p.Expression()
 		}
-		p.SetState(327)
+		p.SetState(422)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) == 1 {
-			p.SetState(324)
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
+			p.SetState(419)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == SqlParserAS {
 				{
-					p.SetState(323)
+					p.SetState(418)
 					p.Match(SqlParserAS)
 				}
 
 			}
 			{
-				p.SetState(326)
+				p.SetState(421)
 				p.Identifier()
 			}
 
@@ -4648,22 +5231,22 @@ func (p *SqlParser) SelectItem() (localctx ISelectItemContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(329)
+			p.SetState(424)
 			p.QualifiedName()
 		}
 		{
-			p.SetState(330)
+			p.SetState(425)
 			p.Match(SqlParserT__0)
 		}
 		{
-			p.SetState(331)
+			p.SetState(426)
 			p.Match(SqlParserASTERISK)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(333)
+			p.SetState(428)
 			p.Match(SqlParserASTERISK)
 		}
 
@@ -4889,14 +5472,14 @@ func (p *SqlParser) relation(_p int) (localctx IRelationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(337)
+		p.SetState(432)
 		p.SampledRelation()
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(347)
+	p.SetState(442)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -4907,35 +5490,35 @@ func (p *SqlParser) relation(_p int) (localctx IRelationContext) {
 			localctx = NewRelationContext(p, _parentctx, _parentState)
 			localctx.(*RelationContext).leftRelation = _prevctx
 			p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_relation)
-			p.SetState(339)
+			p.SetState(434)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
 			{
-				p.SetState(340)
+				p.SetState(435)
 				p.JoinType()
 			}
 			{
-				p.SetState(341)
+				p.SetState(436)
 				p.Match(SqlParserJOIN)
 			}
 			{
-				p.SetState(342)
+				p.SetState(437)
 
 				var _x = p.relation(0)
 
 				localctx.(*RelationContext).rightRelation = _x
 			}
 			{
-				p.SetState(343)
+				p.SetState(438)
 				p.JoinCriteria()
 			}
 
 		}
-		p.SetState(349)
+		p.SetState(444)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -5050,19 +5633,19 @@ func (p *SqlParser) JoinType() (localctx IJoinTypeContext) {
 		}
 	}()
 
-	p.SetState(365)
+	p.SetState(460)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserINNER, SqlParserJOIN:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(351)
+		p.SetState(446)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserINNER {
 			{
-				p.SetState(350)
+				p.SetState(445)
 				p.Match(SqlParserINNER)
 			}
 
@@ -5071,16 +5654,16 @@ func (p *SqlParser) JoinType() (localctx IJoinTypeContext) {
 	case SqlParserLEFT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(353)
+			p.SetState(448)
 			p.Match(SqlParserLEFT)
 		}
-		p.SetState(355)
+		p.SetState(450)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserOUTER {
 			{
-				p.SetState(354)
+				p.SetState(449)
 				p.Match(SqlParserOUTER)
 			}
 
@@ -5089,16 +5672,16 @@ func (p *SqlParser) JoinType() (localctx IJoinTypeContext) {
 	case SqlParserRIGHT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(357)
+			p.SetState(452)
 			p.Match(SqlParserRIGHT)
 		}
-		p.SetState(359)
+		p.SetState(454)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserOUTER {
 			{
-				p.SetState(358)
+				p.SetState(453)
 				p.Match(SqlParserOUTER)
 			}
 
@@ -5107,16 +5690,16 @@ func (p *SqlParser) JoinType() (localctx IJoinTypeContext) {
 	case SqlParserFULL:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(361)
+			p.SetState(456)
 			p.Match(SqlParserFULL)
 		}
-		p.SetState(363)
+		p.SetState(458)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserOUTER {
 			{
-				p.SetState(362)
+				p.SetState(457)
 				p.Match(SqlParserOUTER)
 			}
 
@@ -5283,55 +5866,55 @@ func (p *SqlParser) JoinCriteria() (localctx IJoinCriteriaContext) {
 		}
 	}()
 
-	p.SetState(381)
+	p.SetState(476)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserON:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(367)
+			p.SetState(462)
 			p.Match(SqlParserON)
 		}
 		{
-			p.SetState(368)
+			p.SetState(463)
 			p.booleanExpression(0)
 		}
 
 	case SqlParserUSING:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(369)
+			p.SetState(464)
 			p.Match(SqlParserUSING)
 		}
 		{
-			p.SetState(370)
+			p.SetState(465)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(371)
+			p.SetState(466)
 			p.Identifier()
 		}
-		p.SetState(376)
+		p.SetState(471)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SqlParserT__1 {
 			{
-				p.SetState(372)
+				p.SetState(467)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(373)
+				p.SetState(468)
 				p.Identifier()
 			}
 
-			p.SetState(378)
+			p.SetState(473)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(379)
+			p.SetState(474)
 			p.Match(SqlParserT__3)
 		}
 
@@ -5438,7 +6021,7 @@ func (p *SqlParser) SampleType() (localctx ISampleTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(383)
+		p.SetState(478)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SqlParserBERNOULLI || _la == SqlParserSYSTEM) {
@@ -5577,26 +6160,26 @@ func (p *SqlParser) SampledRelation() (localctx ISampledRelationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(385)
+		p.SetState(480)
 		p.RelationPrimary()
 	}
-	p.SetState(390)
+	p.SetState(485)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
-		p.SetState(387)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) == 1 {
+		p.SetState(482)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserAS {
 			{
-				p.SetState(386)
+				p.SetState(481)
 				p.Match(SqlParserAS)
 			}
 
 		}
 		{
-			p.SetState(389)
+			p.SetState(484)
 			p.Identifier()
 		}
 
@@ -5734,33 +6317,33 @@ func (p *SqlParser) ColumnAliases() (localctx IColumnAliasesContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(392)
+		p.SetState(487)
 		p.Match(SqlParserT__2)
 	}
 	{
-		p.SetState(393)
+		p.SetState(488)
 		p.Identifier()
 	}
-	p.SetState(398)
+	p.SetState(493)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SqlParserT__1 {
 		{
-			p.SetState(394)
+			p.SetState(489)
 			p.Match(SqlParserT__1)
 		}
 		{
-			p.SetState(395)
+			p.SetState(490)
 			p.Identifier()
 		}
 
-		p.SetState(400)
+		p.SetState(495)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(401)
+		p.SetState(496)
 		p.Match(SqlParserT__3)
 	}
 
@@ -5901,43 +6484,43 @@ func (p *SqlParser) RelationPrimary() (localctx IRelationPrimaryContext) {
 		}
 	}()
 
-	p.SetState(412)
+	p.SetState(507)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(403)
+			p.SetState(498)
 			p.QualifiedName()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(404)
+			p.SetState(499)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(405)
+			p.SetState(500)
 			p.Query()
 		}
 		{
-			p.SetState(406)
+			p.SetState(501)
 			p.Match(SqlParserT__3)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(408)
+			p.SetState(503)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(409)
+			p.SetState(504)
 			p.relation(0)
 		}
 		{
-			p.SetState(410)
+			p.SetState(505)
 			p.Match(SqlParserT__3)
 		}
 
@@ -6048,7 +6631,7 @@ func (p *SqlParser) Expression() (localctx IExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(414)
+		p.SetState(509)
 		p.booleanExpression(0)
 	}
 
@@ -6258,23 +6841,23 @@ func (p *SqlParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(420)
+	p.SetState(515)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SqlParserT__2, SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCASE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFALSE, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULL, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRUE, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserPLUS, SqlParserMINUS, SqlParserSTRING, SqlParserINTEGER_VALUE, SqlParserDOUBLE_VALUE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
+	case SqlParserT__2, SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCASE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFALSE, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULL, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRUE, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserPLUS, SqlParserMINUS, SqlParserSTRING, SqlParserINTEGER_VALUE, SqlParserDOUBLE_VALUE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
 		{
-			p.SetState(417)
+			p.SetState(512)
 			p.Predicated()
 		}
 
 	case SqlParserNOT:
 		{
-			p.SetState(418)
+			p.SetState(513)
 			p.Match(SqlParserNOT)
 		}
 		{
-			p.SetState(419)
+			p.SetState(514)
 			p.booleanExpression(3)
 		}
 
@@ -6282,9 +6865,9 @@ func (p *SqlParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(430)
+	p.SetState(525)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -6292,27 +6875,27 @@ func (p *SqlParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(428)
+			p.SetState(523)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewBooleanExpressionContext(p, _parentctx, _parentState)
 				localctx.(*BooleanExpressionContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_booleanExpression)
-				p.SetState(422)
+				p.SetState(517)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(423)
+					p.SetState(518)
 
 					var _m = p.Match(SqlParserAND)
 
 					localctx.(*BooleanExpressionContext).operator = _m
 				}
 				{
-					p.SetState(424)
+					p.SetState(519)
 
 					var _x = p.booleanExpression(3)
 
@@ -6323,20 +6906,20 @@ func (p *SqlParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 				localctx = NewBooleanExpressionContext(p, _parentctx, _parentState)
 				localctx.(*BooleanExpressionContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_booleanExpression)
-				p.SetState(425)
+				p.SetState(520)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(426)
+					p.SetState(521)
 
 					var _m = p.Match(SqlParserOR)
 
 					localctx.(*BooleanExpressionContext).operator = _m
 				}
 				{
-					p.SetState(427)
+					p.SetState(522)
 
 					var _x = p.booleanExpression(2)
 
@@ -6346,9 +6929,9 @@ func (p *SqlParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 			}
 
 		}
-		p.SetState(432)
+		p.SetState(527)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -6473,15 +7056,15 @@ func (p *SqlParser) Predicated() (localctx IPredicatedContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(433)
+		p.SetState(528)
 		p.valueExpression(0)
 	}
-	p.SetState(435)
+	p.SetState(530)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(434)
+			p.SetState(529)
 			p.Predicate()
 		}
 
@@ -6816,17 +7399,17 @@ func (p *SqlParser) Predicate() (localctx IPredicateContext) {
 		}
 	}()
 
-	p.SetState(498)
+	p.SetState(593)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(437)
+			p.SetState(532)
 			p.ComparisonOperator()
 		}
 		{
-			p.SetState(438)
+			p.SetState(533)
 
 			var _x = p.valueExpression(0)
 
@@ -6836,56 +7419,56 @@ func (p *SqlParser) Predicate() (localctx IPredicateContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(440)
+			p.SetState(535)
 			p.ComparisonOperator()
 		}
 		{
-			p.SetState(441)
+			p.SetState(536)
 			p.ComparisonQuantifier()
 		}
 		{
-			p.SetState(442)
+			p.SetState(537)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(443)
+			p.SetState(538)
 			p.Query()
 		}
 		{
-			p.SetState(444)
+			p.SetState(539)
 			p.Match(SqlParserT__3)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(447)
+		p.SetState(542)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserNOT {
 			{
-				p.SetState(446)
+				p.SetState(541)
 				p.Match(SqlParserNOT)
 			}
 
 		}
 		{
-			p.SetState(449)
+			p.SetState(544)
 			p.Match(SqlParserBETWEEN)
 		}
 		{
-			p.SetState(450)
+			p.SetState(545)
 
 			var _x = p.valueExpression(0)
 
 			localctx.(*PredicateContext).lower = _x
 		}
 		{
-			p.SetState(451)
+			p.SetState(546)
 			p.Match(SqlParserAND)
 		}
 		{
-			p.SetState(452)
+			p.SetState(547)
 
 			var _x = p.valueExpression(0)
 
@@ -6894,116 +7477,116 @@ func (p *SqlParser) Predicate() (localctx IPredicateContext) {
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
-		p.SetState(455)
+		p.SetState(550)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserNOT {
 			{
-				p.SetState(454)
+				p.SetState(549)
 				p.Match(SqlParserNOT)
 			}
 
 		}
 		{
-			p.SetState(457)
+			p.SetState(552)
 			p.Match(SqlParserIN)
 		}
 		{
-			p.SetState(458)
+			p.SetState(553)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(459)
+			p.SetState(554)
 			p.Expression()
 		}
-		p.SetState(464)
+		p.SetState(559)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SqlParserT__1 {
 			{
-				p.SetState(460)
+				p.SetState(555)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(461)
+				p.SetState(556)
 				p.Expression()
 			}
 
-			p.SetState(466)
+			p.SetState(561)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(467)
+			p.SetState(562)
 			p.Match(SqlParserT__3)
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
-		p.SetState(470)
+		p.SetState(565)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserNOT {
 			{
-				p.SetState(469)
+				p.SetState(564)
 				p.Match(SqlParserNOT)
 			}
 
 		}
 		{
-			p.SetState(472)
+			p.SetState(567)
 			p.Match(SqlParserIN)
 		}
 		{
-			p.SetState(473)
+			p.SetState(568)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(474)
+			p.SetState(569)
 			p.Query()
 		}
 		{
-			p.SetState(475)
+			p.SetState(570)
 			p.Match(SqlParserT__3)
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
-		p.SetState(478)
+		p.SetState(573)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserNOT {
 			{
-				p.SetState(477)
+				p.SetState(572)
 				p.Match(SqlParserNOT)
 			}
 
 		}
 		{
-			p.SetState(480)
+			p.SetState(575)
 			p.Match(SqlParserLIKE)
 		}
 		{
-			p.SetState(481)
+			p.SetState(576)
 
 			var _x = p.valueExpression(0)
 
 			localctx.(*PredicateContext).pattern = _x
 		}
-		p.SetState(484)
+		p.SetState(579)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(482)
+				p.SetState(577)
 				p.Match(SqlParserESCAPE)
 			}
 			{
-				p.SetState(483)
+				p.SetState(578)
 
 				var _x = p.valueExpression(0)
 
@@ -7015,52 +7598,52 @@ func (p *SqlParser) Predicate() (localctx IPredicateContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(486)
+			p.SetState(581)
 			p.Match(SqlParserIS)
 		}
-		p.SetState(488)
+		p.SetState(583)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserNOT {
 			{
-				p.SetState(487)
+				p.SetState(582)
 				p.Match(SqlParserNOT)
 			}
 
 		}
 		{
-			p.SetState(490)
+			p.SetState(585)
 			p.Match(SqlParserNULL)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(491)
+			p.SetState(586)
 			p.Match(SqlParserIS)
 		}
-		p.SetState(493)
+		p.SetState(588)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserNOT {
 			{
-				p.SetState(492)
+				p.SetState(587)
 				p.Match(SqlParserNOT)
 			}
 
 		}
 		{
-			p.SetState(495)
+			p.SetState(590)
 			p.Match(SqlParserDISTINCT)
 		}
 		{
-			p.SetState(496)
+			p.SetState(591)
 			p.Match(SqlParserFROM)
 		}
 		{
-			p.SetState(497)
+			p.SetState(592)
 
 			var _x = p.valueExpression(0)
 
@@ -7291,19 +7874,19 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(504)
+	p.SetState(599)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SqlParserT__2, SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCASE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFALSE, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULL, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRUE, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserSTRING, SqlParserINTEGER_VALUE, SqlParserDOUBLE_VALUE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
+	case SqlParserT__2, SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCASE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFALSE, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULL, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRUE, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserSTRING, SqlParserINTEGER_VALUE, SqlParserDOUBLE_VALUE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
 		{
-			p.SetState(501)
+			p.SetState(596)
 			p.primaryExpression(0)
 		}
 
 	case SqlParserPLUS, SqlParserMINUS:
 		{
-			p.SetState(502)
+			p.SetState(597)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -7321,7 +7904,7 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 			}
 		}
 		{
-			p.SetState(503)
+			p.SetState(598)
 			p.valueExpression(4)
 		}
 
@@ -7329,9 +7912,9 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(517)
+	p.SetState(612)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -7339,20 +7922,20 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(515)
+			p.SetState(610)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewValueExpressionContext(p, _parentctx, _parentState)
 				localctx.(*ValueExpressionContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_valueExpression)
-				p.SetState(506)
+				p.SetState(601)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(507)
+					p.SetState(602)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -7360,7 +7943,7 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-193)) & ^0x3f) == 0 && ((int64(1)<<(_la-193))&7) != 0) {
+					if !((int64((_la-196)) & ^0x3f) == 0 && ((int64(1)<<(_la-196))&7) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*ValueExpressionContext).operator = _ri
@@ -7370,7 +7953,7 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 					}
 				}
 				{
-					p.SetState(508)
+					p.SetState(603)
 
 					var _x = p.valueExpression(4)
 
@@ -7381,13 +7964,13 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 				localctx = NewValueExpressionContext(p, _parentctx, _parentState)
 				localctx.(*ValueExpressionContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_valueExpression)
-				p.SetState(509)
+				p.SetState(604)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(510)
+					p.SetState(605)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -7405,7 +7988,7 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 					}
 				}
 				{
-					p.SetState(511)
+					p.SetState(606)
 
 					var _x = p.valueExpression(3)
 
@@ -7416,17 +7999,17 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 				localctx = NewValueExpressionContext(p, _parentctx, _parentState)
 				localctx.(*ValueExpressionContext).left = _prevctx
 				p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_valueExpression)
-				p.SetState(512)
+				p.SetState(607)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(513)
+					p.SetState(608)
 					p.Match(SqlParserCONCAT)
 				}
 				{
-					p.SetState(514)
+					p.SetState(609)
 
 					var _x = p.valueExpression(2)
 
@@ -7436,9 +8019,9 @@ func (p *SqlParser) valueExpression(_p int) (localctx IValueExpressionContext) {
 			}
 
 		}
-		p.SetState(519)
+		p.SetState(614)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -7851,169 +8434,169 @@ func (p *SqlParser) primaryExpression(_p int) (localctx IPrimaryExpressionContex
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(574)
+	p.SetState(669)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(521)
+			p.SetState(616)
 			p.Match(SqlParserNULL)
 		}
 
 	case 2:
 		{
-			p.SetState(522)
+			p.SetState(617)
 			p.Identifier()
 		}
 		{
-			p.SetState(523)
+			p.SetState(618)
 			p.StringValue()
 		}
 
 	case 3:
 		{
-			p.SetState(525)
+			p.SetState(620)
 			p.Number()
 		}
 
 	case 4:
 		{
-			p.SetState(526)
+			p.SetState(621)
 			p.BooleanValue()
 		}
 
 	case 5:
 		{
-			p.SetState(527)
+			p.SetState(622)
 			p.StringValue()
 		}
 
 	case 6:
 		{
-			p.SetState(528)
+			p.SetState(623)
 			p.Identifier()
 		}
 
 	case 7:
 		{
-			p.SetState(529)
+			p.SetState(624)
 			p.QualifiedName()
 		}
 		{
-			p.SetState(530)
+			p.SetState(625)
 			p.Match(SqlParserT__2)
 		}
-		p.SetState(542)
+		p.SetState(637)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3407031263907605864) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9077690585429136783) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&-9087327815023477057) != 0) || ((int64((_la-192)) & ^0x3f) == 0 && ((int64(1)<<(_la-192))&7969) != 0) {
-			p.SetState(532)
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&6814062527817510248) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&-291362902405196401) != 0) || ((int64((_la-130)) & ^0x3f) == 0 && ((int64(1)<<(_la-130))&272088443662597503) != 0) || ((int64((_la-194)) & ^0x3f) == 0 && ((int64(1)<<(_la-194))&15939) != 0) {
+			p.SetState(627)
 			p.GetErrorHandler().Sync(p)
 
-			if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
+			if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) == 1 {
 				{
-					p.SetState(531)
+					p.SetState(626)
 					p.SetQuantifier()
 				}
 
 			}
 			{
-				p.SetState(534)
+				p.SetState(629)
 				p.Expression()
 			}
-			p.SetState(539)
+			p.SetState(634)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == SqlParserT__1 {
 				{
-					p.SetState(535)
+					p.SetState(630)
 					p.Match(SqlParserT__1)
 				}
 				{
-					p.SetState(536)
+					p.SetState(631)
 					p.Expression()
 				}
 
-				p.SetState(541)
+				p.SetState(636)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 
 		}
-		p.SetState(554)
+		p.SetState(649)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserORDER {
 			{
-				p.SetState(544)
+				p.SetState(639)
 				p.Match(SqlParserORDER)
 			}
 			{
-				p.SetState(545)
+				p.SetState(640)
 				p.Match(SqlParserBY)
 			}
 			{
-				p.SetState(546)
+				p.SetState(641)
 				p.SortItem()
 			}
-			p.SetState(551)
+			p.SetState(646)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == SqlParserT__1 {
 				{
-					p.SetState(547)
+					p.SetState(642)
 					p.Match(SqlParserT__1)
 				}
 				{
-					p.SetState(548)
+					p.SetState(643)
 					p.SortItem()
 				}
 
-				p.SetState(553)
+				p.SetState(648)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 
 		}
 		{
-			p.SetState(556)
+			p.SetState(651)
 			p.Match(SqlParserT__3)
 		}
 
 	case 8:
 		{
-			p.SetState(558)
+			p.SetState(653)
 			p.Match(SqlParserCASE)
 		}
-		p.SetState(560)
+		p.SetState(655)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ok := true; ok; ok = _la == SqlParserWHEN {
 			{
-				p.SetState(559)
+				p.SetState(654)
 				p.WhenClause()
 			}
 
-			p.SetState(562)
+			p.SetState(657)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(566)
+		p.SetState(661)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SqlParserELSE {
 			{
-				p.SetState(564)
+				p.SetState(659)
 				p.Match(SqlParserELSE)
 			}
 			{
-				p.SetState(565)
+				p.SetState(660)
 
 				var _x = p.Expression()
 
@@ -8022,29 +8605,29 @@ func (p *SqlParser) primaryExpression(_p int) (localctx IPrimaryExpressionContex
 
 		}
 		{
-			p.SetState(568)
+			p.SetState(663)
 			p.Match(SqlParserEND)
 		}
 
 	case 9:
 		{
-			p.SetState(570)
+			p.SetState(665)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(571)
+			p.SetState(666)
 			p.Expression()
 		}
 		{
-			p.SetState(572)
+			p.SetState(667)
 			p.Match(SqlParserT__3)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(581)
+	p.SetState(676)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -8055,17 +8638,17 @@ func (p *SqlParser) primaryExpression(_p int) (localctx IPrimaryExpressionContex
 			localctx = NewPrimaryExpressionContext(p, _parentctx, _parentState)
 			localctx.(*PrimaryExpressionContext).base = _prevctx
 			p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_primaryExpression)
-			p.SetState(576)
+			p.SetState(671)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 			}
 			{
-				p.SetState(577)
+				p.SetState(672)
 				p.Match(SqlParserT__0)
 			}
 			{
-				p.SetState(578)
+				p.SetState(673)
 
 				var _x = p.Identifier()
 
@@ -8073,9 +8656,9 @@ func (p *SqlParser) primaryExpression(_p int) (localctx IPrimaryExpressionContex
 			}
 
 		}
-		p.SetState(583)
+		p.SetState(678)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8171,7 +8754,7 @@ func (p *SqlParser) StringValue() (localctx IStringValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(584)
+		p.SetState(679)
 		p.Match(SqlParserSTRING)
 	}
 
@@ -8294,10 +8877,10 @@ func (p *SqlParser) ComparisonOperator() (localctx IComparisonOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(586)
+		p.SetState(681)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-185)) & ^0x3f) == 0 && ((int64(1)<<(_la-185))&63) != 0) {
+		if !((int64((_la-188)) & ^0x3f) == 0 && ((int64(1)<<(_la-188))&63) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -8409,7 +8992,7 @@ func (p *SqlParser) ComparisonQuantifier() (localctx IComparisonQuantifierContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(588)
+		p.SetState(683)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SqlParserALL || _la == SqlParserANY || _la == SqlParserSOME) {
@@ -8519,7 +9102,7 @@ func (p *SqlParser) BooleanValue() (localctx IBooleanValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(590)
+		p.SetState(685)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SqlParserFALSE || _la == SqlParserTRUE) {
@@ -8800,134 +9383,134 @@ func (p *SqlParser) typeSql(_p int) (localctx ITypeSqlContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(634)
+	p.SetState(729)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(593)
+			p.SetState(688)
 			p.Match(SqlParserARRAY)
 		}
 		{
-			p.SetState(594)
+			p.SetState(689)
 			p.Match(SqlParserLT)
 		}
 		{
-			p.SetState(595)
+			p.SetState(690)
 			p.typeSql(0)
 		}
 		{
-			p.SetState(596)
+			p.SetState(691)
 			p.Match(SqlParserGT)
 		}
 
 	case 2:
 		{
-			p.SetState(598)
+			p.SetState(693)
 			p.Match(SqlParserMAP)
 		}
 		{
-			p.SetState(599)
+			p.SetState(694)
 			p.Match(SqlParserLT)
 		}
 		{
-			p.SetState(600)
+			p.SetState(695)
 			p.typeSql(0)
 		}
 		{
-			p.SetState(601)
+			p.SetState(696)
 			p.Match(SqlParserT__1)
 		}
 		{
-			p.SetState(602)
+			p.SetState(697)
 			p.typeSql(0)
 		}
 		{
-			p.SetState(603)
+			p.SetState(698)
 			p.Match(SqlParserGT)
 		}
 
 	case 3:
 		{
-			p.SetState(605)
+			p.SetState(700)
 			p.Match(SqlParserROW)
 		}
 		{
-			p.SetState(606)
+			p.SetState(701)
 			p.Match(SqlParserT__2)
 		}
 		{
-			p.SetState(607)
+			p.SetState(702)
 			p.Identifier()
 		}
 		{
-			p.SetState(608)
+			p.SetState(703)
 			p.typeSql(0)
 		}
-		p.SetState(615)
+		p.SetState(710)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SqlParserT__1 {
 			{
-				p.SetState(609)
+				p.SetState(704)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(610)
+				p.SetState(705)
 				p.Identifier()
 			}
 			{
-				p.SetState(611)
+				p.SetState(706)
 				p.typeSql(0)
 			}
 
-			p.SetState(617)
+			p.SetState(712)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(618)
+			p.SetState(713)
 			p.Match(SqlParserT__3)
 		}
 
 	case 4:
 		{
-			p.SetState(620)
+			p.SetState(715)
 			p.BaseType()
 		}
-		p.SetState(632)
+		p.SetState(727)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(621)
+				p.SetState(716)
 				p.Match(SqlParserT__2)
 			}
 			{
-				p.SetState(622)
+				p.SetState(717)
 				p.TypeParameter()
 			}
-			p.SetState(627)
+			p.SetState(722)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == SqlParserT__1 {
 				{
-					p.SetState(623)
+					p.SetState(718)
 					p.Match(SqlParserT__1)
 				}
 				{
-					p.SetState(624)
+					p.SetState(719)
 					p.TypeParameter()
 				}
 
-				p.SetState(629)
+				p.SetState(724)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(630)
+				p.SetState(725)
 				p.Match(SqlParserT__3)
 			}
 
@@ -8935,9 +9518,9 @@ func (p *SqlParser) typeSql(_p int) (localctx ITypeSqlContext) {
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(640)
+	p.SetState(735)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -8947,20 +9530,20 @@ func (p *SqlParser) typeSql(_p int) (localctx ITypeSqlContext) {
 			_prevctx = localctx
 			localctx = NewTypeSqlContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SqlParserRULE_typeSql)
-			p.SetState(636)
+			p.SetState(731)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 			}
 			{
-				p.SetState(637)
+				p.SetState(732)
 				p.Match(SqlParserARRAY)
 			}
 
 		}
-		p.SetState(642)
+		p.SetState(737)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9071,21 +9654,21 @@ func (p *SqlParser) TypeParameter() (localctx ITypeParameterContext) {
 		}
 	}()
 
-	p.SetState(645)
+	p.SetState(740)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserINTEGER_VALUE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(643)
+			p.SetState(738)
 			p.Match(SqlParserINTEGER_VALUE)
 		}
 
-	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER, SqlParserTIME_WITH_TIME_ZONE, SqlParserTIMESTAMP_WITH_TIME_ZONE, SqlParserDOUBLE_PRECISION:
+	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER, SqlParserTIME_WITH_TIME_ZONE, SqlParserTIMESTAMP_WITH_TIME_ZONE, SqlParserDOUBLE_PRECISION:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(644)
+			p.SetState(739)
 			p.typeSql(0)
 		}
 
@@ -9211,35 +9794,35 @@ func (p *SqlParser) BaseType() (localctx IBaseTypeContext) {
 		}
 	}()
 
-	p.SetState(651)
+	p.SetState(746)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserTIME_WITH_TIME_ZONE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(647)
+			p.SetState(742)
 			p.Match(SqlParserTIME_WITH_TIME_ZONE)
 		}
 
 	case SqlParserTIMESTAMP_WITH_TIME_ZONE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(648)
+			p.SetState(743)
 			p.Match(SqlParserTIMESTAMP_WITH_TIME_ZONE)
 		}
 
 	case SqlParserDOUBLE_PRECISION:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(649)
+			p.SetState(744)
 			p.Match(SqlParserDOUBLE_PRECISION)
 		}
 
-	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
+	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(650)
+			p.SetState(745)
 			p.Identifier()
 		}
 
@@ -9410,22 +9993,22 @@ func (p *SqlParser) WhenClause() (localctx IWhenClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(653)
+		p.SetState(748)
 		p.Match(SqlParserWHEN)
 	}
 	{
-		p.SetState(654)
+		p.SetState(749)
 
 		var _x = p.Expression()
 
 		localctx.(*WhenClauseContext).condition = _x
 	}
 	{
-		p.SetState(655)
+		p.SetState(750)
 		p.Match(SqlParserTHEN)
 	}
 	{
-		p.SetState(656)
+		p.SetState(751)
 
 		var _x = p.Expression()
 
@@ -9547,23 +10130,23 @@ func (p *SqlParser) Filter() (localctx IFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(658)
+		p.SetState(753)
 		p.Match(SqlParserFILTER)
 	}
 	{
-		p.SetState(659)
+		p.SetState(754)
 		p.Match(SqlParserT__2)
 	}
 	{
-		p.SetState(660)
+		p.SetState(755)
 		p.Match(SqlParserWHERE)
 	}
 	{
-		p.SetState(661)
+		p.SetState(756)
 		p.booleanExpression(0)
 	}
 	{
-		p.SetState(662)
+		p.SetState(757)
 		p.Match(SqlParserT__3)
 	}
 
@@ -9789,45 +10372,45 @@ func (p *SqlParser) Over() (localctx IOverContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(664)
+		p.SetState(759)
 		p.Match(SqlParserOVER)
 	}
 	{
-		p.SetState(665)
+		p.SetState(760)
 		p.Match(SqlParserT__2)
 	}
-	p.SetState(676)
+	p.SetState(771)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserPARTITION {
 		{
-			p.SetState(666)
+			p.SetState(761)
 			p.Match(SqlParserPARTITION)
 		}
 		{
-			p.SetState(667)
+			p.SetState(762)
 			p.Match(SqlParserBY)
 		}
 		{
-			p.SetState(668)
+			p.SetState(763)
 
 			var _x = p.Expression()
 
 			localctx.(*OverContext)._expression = _x
 		}
 		localctx.(*OverContext).partition = append(localctx.(*OverContext).partition, localctx.(*OverContext)._expression)
-		p.SetState(673)
+		p.SetState(768)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SqlParserT__1 {
 			{
-				p.SetState(669)
+				p.SetState(764)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(670)
+				p.SetState(765)
 
 				var _x = p.Expression()
 
@@ -9835,51 +10418,51 @@ func (p *SqlParser) Over() (localctx IOverContext) {
 			}
 			localctx.(*OverContext).partition = append(localctx.(*OverContext).partition, localctx.(*OverContext)._expression)
 
-			p.SetState(675)
+			p.SetState(770)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
-	p.SetState(688)
+	p.SetState(783)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SqlParserORDER {
 		{
-			p.SetState(678)
+			p.SetState(773)
 			p.Match(SqlParserORDER)
 		}
 		{
-			p.SetState(679)
+			p.SetState(774)
 			p.Match(SqlParserBY)
 		}
 		{
-			p.SetState(680)
+			p.SetState(775)
 			p.SortItem()
 		}
-		p.SetState(685)
+		p.SetState(780)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SqlParserT__1 {
 			{
-				p.SetState(681)
+				p.SetState(776)
 				p.Match(SqlParserT__1)
 			}
 			{
-				p.SetState(682)
+				p.SetState(777)
 				p.SortItem()
 			}
 
-			p.SetState(687)
+			p.SetState(782)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(690)
+		p.SetState(785)
 		p.Match(SqlParserT__3)
 	}
 
@@ -10001,35 +10584,35 @@ func (p *SqlParser) Privilege() (localctx IPrivilegeContext) {
 		}
 	}()
 
-	p.SetState(696)
+	p.SetState(791)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserSELECT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(692)
+			p.SetState(787)
 			p.Match(SqlParserSELECT)
 		}
 
 	case SqlParserDELETE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(693)
+			p.SetState(788)
 			p.Match(SqlParserDELETE)
 		}
 
 	case SqlParserINSERT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(694)
+			p.SetState(789)
 			p.Match(SqlParserINSERT)
 		}
 
-	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
+	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE, SqlParserIDENTIFIER, SqlParserDIGIT_IDENTIFIER, SqlParserQUOTED_IDENTIFIER:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(695)
+			p.SetState(790)
 			p.Identifier()
 		}
 
@@ -10170,28 +10753,28 @@ func (p *SqlParser) QualifiedName() (localctx IQualifiedNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(698)
+		p.SetState(793)
 		p.Identifier()
 	}
-	p.SetState(703)
+	p.SetState(798)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(699)
+				p.SetState(794)
 				p.Match(SqlParserT__0)
 			}
 			{
-				p.SetState(700)
+				p.SetState(795)
 				p.Identifier()
 			}
 
 		}
-		p.SetState(705)
+		p.SetState(800)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10312,35 +10895,35 @@ func (p *SqlParser) Identifier() (localctx IIdentifierContext) {
 		}
 	}()
 
-	p.SetState(710)
+	p.SetState(805)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SqlParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(706)
+			p.SetState(801)
 			p.Match(SqlParserIDENTIFIER)
 		}
 
 	case SqlParserQUOTED_IDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(707)
+			p.SetState(802)
 			p.Match(SqlParserQUOTED_IDENTIFIER)
 		}
 
-	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE:
+	case SqlParserADD, SqlParserALL, SqlParserANALYZE, SqlParserANY, SqlParserARRAY, SqlParserASC, SqlParserAT, SqlParserBERNOULLI, SqlParserCALL, SqlParserCASCADE, SqlParserCATALOG, SqlParserCATALOGS, SqlParserCOALESCE, SqlParserCOLUMN, SqlParserCOLUMNS, SqlParserCOMMENT, SqlParserCOMMIT, SqlParserCOMMITTED, SqlParserCURRENT, SqlParserDATA, SqlParserDATE, SqlParserDAY, SqlParserDESC, SqlParserDISTRIBUTED, SqlParserEXCLUDING, SqlParserEXPLAIN, SqlParserFILTER, SqlParserFIRST, SqlParserFOLLOWING, SqlParserFORMAT, SqlParserFUNCTIONS, SqlParserGRANT, SqlParserGRANTS, SqlParserGRAPHVIZ, SqlParserHOUR, SqlParserIF, SqlParserINCLUDING, SqlParserINPUT, SqlParserINTEGER, SqlParserINTERVAL, SqlParserISOLATION, SqlParserLAST, SqlParserLATERAL, SqlParserLEVEL, SqlParserLIMIT, SqlParserLOGICAL, SqlParserMAP, SqlParserMETADATA, SqlParserMINUTE, SqlParserMONTH, SqlParserNFC, SqlParserNFD, SqlParserNFKC, SqlParserNFKD, SqlParserNO, SqlParserNULLIF, SqlParserNULLS, SqlParserONLY, SqlParserOPTION, SqlParserORDINALITY, SqlParserOUTPUT, SqlParserOVER, SqlParserPARTITION, SqlParserPARTITIONS, SqlParserPOSITION, SqlParserPRECEDING, SqlParserPRIVILEGES, SqlParserPROPERTIES, SqlParserPUBLIC, SqlParserRANGE, SqlParserREAD, SqlParserREFRESH, SqlParserRENAME, SqlParserREPEATABLE, SqlParserREPLACE, SqlParserRESET, SqlParserRESTRICT, SqlParserREVOKE, SqlParserROLLBACK, SqlParserROW, SqlParserROWS, SqlParserSCHEMA, SqlParserSCHEMAS, SqlParserSECOND, SqlParserSERIALIZABLE, SqlParserSESSION, SqlParserSET, SqlParserSETS, SqlParserSHOW, SqlParserSMALLINT, SqlParserSOME, SqlParserSTART, SqlParserSTATS, SqlParserSUBSTRING, SqlParserSYSTEM, SqlParserTABLES, SqlParserTABLESAMPLE, SqlParserTEXT, SqlParserTIME, SqlParserTIMESTAMP, SqlParserTINYINT, SqlParserTO, SqlParserTRANSACTION, SqlParserTRY_CAST, SqlParserTYPE, SqlParserUNBOUNDED, SqlParserUNCOMMITTED, SqlParserUSE, SqlParserVALIDATE, SqlParserVERBOSE, SqlParserVIEW, SqlParserWORK, SqlParserWRITE, SqlParserYEAR, SqlParserZONE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(708)
+			p.SetState(803)
 			p.NonReserved()
 		}
 
 	case SqlParserDIGIT_IDENTIFIER:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(709)
+			p.SetState(804)
 			p.Match(SqlParserDIGIT_IDENTIFIER)
 		}
 
@@ -10447,7 +11030,7 @@ func (p *SqlParser) Number() (localctx INumberContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(712)
+		p.SetState(807)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SqlParserINTEGER_VALUE || _la == SqlParserDOUBLE_VALUE) {
@@ -10479,6 +11062,7 @@ type INonReservedContext interface {
 	BERNOULLI() antlr.TerminalNode
 	CALL() antlr.TerminalNode
 	CASCADE() antlr.TerminalNode
+	CATALOG() antlr.TerminalNode
 	CATALOGS() antlr.TerminalNode
 	COALESCE() antlr.TerminalNode
 	COLUMN() antlr.TerminalNode
@@ -10515,6 +11099,7 @@ type INonReservedContext interface {
 	LIMIT() antlr.TerminalNode
 	LOGICAL() antlr.TerminalNode
 	MAP() antlr.TerminalNode
+	METADATA() antlr.TerminalNode
 	MINUTE() antlr.TerminalNode
 	MONTH() antlr.TerminalNode
 	NFC() antlr.TerminalNode
@@ -10538,6 +11123,7 @@ type INonReservedContext interface {
 	PUBLIC() antlr.TerminalNode
 	RANGE() antlr.TerminalNode
 	READ() antlr.TerminalNode
+	REFRESH() antlr.TerminalNode
 	RENAME() antlr.TerminalNode
 	REPEATABLE() antlr.TerminalNode
 	REPLACE() antlr.TerminalNode
@@ -10653,6 +11239,10 @@ func (s *NonReservedContext) CASCADE() antlr.TerminalNode {
 	return s.GetToken(SqlParserCASCADE, 0)
 }
 
+func (s *NonReservedContext) CATALOG() antlr.TerminalNode {
+	return s.GetToken(SqlParserCATALOG, 0)
+}
+
 func (s *NonReservedContext) CATALOGS() antlr.TerminalNode {
 	return s.GetToken(SqlParserCATALOGS, 0)
 }
@@ -10797,6 +11387,10 @@ func (s *NonReservedContext) MAP() antlr.TerminalNode {
 	return s.GetToken(SqlParserMAP, 0)
 }
 
+func (s *NonReservedContext) METADATA() antlr.TerminalNode {
+	return s.GetToken(SqlParserMETADATA, 0)
+}
+
 func (s *NonReservedContext) MINUTE() antlr.TerminalNode {
 	return s.GetToken(SqlParserMINUTE, 0)
 }
@@ -10889,6 +11483,10 @@ func (s *NonReservedContext) READ() antlr.TerminalNode {
 	return s.GetToken(SqlParserREAD, 0)
 }
 
+func (s *NonReservedContext) REFRESH() antlr.TerminalNode {
+	return s.GetToken(SqlParserREFRESH, 0)
+}
+
 func (s *NonReservedContext) RENAME() antlr.TerminalNode {
 	return s.GetToken(SqlParserRENAME, 0)
 }
@@ -11107,10 +11705,10 @@ func (p *SqlParser) NonReserved() (localctx INonReservedContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(714)
+		p.SetState(809)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3334956077682584928) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&9077687286894253455) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&136044153111822015) != 0)) {
+		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&6669912155368516960) != 0) || ((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&-291369499474963057) != 0) || ((int64((_la-130)) & ^0x3f) == 0 && ((int64(1)<<(_la-130))&272088306223644031) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
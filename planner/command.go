@@ -0,0 +1,379 @@
+package planner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/pkg/parser"
+	"github.com/gotodb/gotodb/row"
+	"github.com/gotodb/gotodb/store"
+	"gopkg.in/yaml.v3"
+)
+
+// Command is a statement the coordinator runs by itself instead of planning it for the workers.
+type Command interface {
+	Run() (*metadata.Metadata, row.Reader, error)
+}
+
+// NewCommandFromStatement returns the command of a statement the coordinator runs by itself, nil
+// if the statement is planned for the workers.
+func NewCommandFromStatement(runtime *config.Runtime, tt *parser.StatementContext) (Command, error) {
+	switch {
+	case tt.REFRESH() != nil:
+		res := &RefreshMetadataCommand{}
+		if id := tt.GetCatalog(); id != nil {
+			res.Catalog = NewIdentifierNode(runtime, id).GetText()
+		}
+		return res, nil
+
+	case tt.CREATE() != nil && tt.CATALOG() != nil:
+		res := &CreateCatalogCommand{
+			Name:        NewIdentifierNode(runtime, tt.GetCatalog()).GetText(),
+			IfNotExists: tt.EXISTS() != nil,
+			Catalog:     &config.Catalog{Type: NewIdentifierNode(runtime, tt.GetConnectorType()).GetText()},
+		}
+		var err error
+		if res.Catalog.Properties, err = NewProperties(runtime, tt.Properties()); err != nil {
+			return nil, err
+		}
+		return res, nil
+
+	case tt.DROP() != nil && tt.CATALOG() != nil:
+		return &DropCatalogCommand{
+			Name:     NewIdentifierNode(runtime, tt.GetCatalog()).GetText(),
+			IfExists: tt.EXISTS() != nil,
+		}, nil
+
+	case tt.CREATE() != nil && tt.TABLE() != nil && tt.SHOW() == nil && tt.Query() == nil:
+		res := &CreateTableCommand{IfNotExists: tt.EXISTS() != nil, Like: map[int]string{}, Runtime: runtime}
+		name := NewQualifiedNameNode(runtime, tt.QualifiedName()).Result()
+		res.Catalog, res.Schema, res.Table = metadata.SplitTableName(runtime, name)
+		for _, element := range tt.AllTableElement() {
+			te := element.(*parser.TableElementContext)
+			if like, ok := te.LikeClause().(*parser.LikeClauseContext); ok {
+				if like.INCLUDING() != nil {
+					start := like.GetOptionType()
+					return nil, fmt.Errorf("line %d:%d  INCLUDING PROPERTIES is not supported", start.GetLine(), start.GetColumn())
+				}
+				res.Like[len(res.Columns)] = NewQualifiedNameNode(runtime, like.QualifiedName()).Result()
+				continue
+			}
+			column := te.ColumnDefinition().(*parser.ColumnDefinitionContext)
+			columnType, err := newColumnType(column.TypeSql())
+			if err != nil {
+				return nil, err
+			}
+			columnName := NewIdentifierNode(runtime, column.Identifier()).GetText()
+			res.Columns = append(res.Columns, metadata.NewColumnMetadata(columnType, res.Catalog, res.Schema, res.Table, columnName))
+		}
+		var err error
+		if res.Properties, err = NewProperties(runtime, tt.Properties()); err != nil {
+			return nil, err
+		}
+		return res, nil
+
+	case tt.DROP() != nil && tt.TABLE() != nil:
+		res := &DropTableCommand{IfExists: tt.EXISTS() != nil}
+		name := NewQualifiedNameNode(runtime, tt.QualifiedName()).Result()
+		res.Catalog, res.Schema, res.Table = metadata.SplitTableName(runtime, name)
+		return res, nil
+
+	case tt.SHOW() != nil && tt.VIEW() != nil:
+		res := &ShowCreateViewCommand{}
+		name := NewQualifiedNameNode(runtime, tt.QualifiedName()).Result()
+		res.Catalog, res.Schema, res.Table = metadata.SplitTableName(runtime, name)
+		return res, nil
+
+	case tt.CREATE() != nil && tt.VIEW() != nil:
+		res := &CreateViewCommand{OrReplace: tt.REPLACE() != nil, Runtime: runtime}
+		name := NewQualifiedNameNode(runtime, tt.QualifiedName()).Result()
+		res.Catalog, res.Schema, res.Table = metadata.SplitTableName(runtime, name)
+		// the query is kept as it is written, the case of the keywords is changed for the lexer only
+		start, stop := tt.Query().GetStart(), tt.Query().GetStop()
+		res.Query = start.GetInputStream().GetText(start.GetStart(), stop.GetStop())
+		return res, nil
+
+	case tt.DROP() != nil && tt.VIEW() != nil:
+		res := &DropViewCommand{IfExists: tt.EXISTS() != nil}
+		name := NewQualifiedNameNode(runtime, tt.QualifiedName()).Result()
+		res.Catalog, res.Schema, res.Table = metadata.SplitTableName(runtime, name)
+		return res, nil
+	}
+	return nil, nil
+}
+
+// newColumnType returns the type of a column definition, the parameters of a type like
+// VARCHAR(20) are left out.
+func newColumnType(t parser.ITypeSqlContext) (datatype.Type, error) {
+	tt := t.(*parser.TypeSqlContext)
+	name := tt.GetText()
+	if base := tt.BaseType(); base != nil {
+		name = strings.Join(strings.Fields(base.GetText()), " ")
+	}
+	res := datatype.FromSql(name)
+	if res == datatype.UnknownType {
+		start := tt.GetStart()
+		return res, fmt.Errorf("line %d:%d  unknown type %s", start.GetLine(), start.GetColumn(), name)
+	}
+	return res, nil
+}
+
+// NewProperties converts the WITH (name = value, ...) of a statement into a mapping, a value is a
+// string, a number, TRUE, FALSE or ARRAY(value, ...).
+func NewProperties(runtime *config.Runtime, t parser.IPropertiesContext) (yaml.Node, error) {
+	res := yaml.Node{Kind: yaml.MappingNode}
+	if t == nil {
+		return res, nil
+	}
+	for _, property := range t.(*parser.PropertiesContext).AllProperty() {
+		tt := property.(*parser.PropertyContext)
+		value, err := newPropertyValue(runtime, tt.Expression())
+		if err != nil {
+			return res, err
+		}
+		name := NewIdentifierNode(runtime, tt.Identifier()).GetText()
+		res.Content = append(res.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}, value)
+	}
+	return res, nil
+}
+
+func newPropertyValue(runtime *config.Runtime, t parser.IExpressionContext) (*yaml.Node, error) {
+	tt := t.(*parser.ExpressionContext)
+	be := tt.BooleanExpression().(*parser.BooleanExpressionContext)
+	predicated, ok := be.Predicated().(*parser.PredicatedContext)
+	if !ok || predicated.Predicate() != nil {
+		return nil, propertyValueError(tt)
+	}
+	sign := ""
+	ve := predicated.ValueExpression().(*parser.ValueExpressionContext)
+	if ve.MINUS() != nil && len(ve.AllValueExpression()) == 1 {
+		sign, ve = "-", ve.ValueExpression(0).(*parser.ValueExpressionContext)
+	}
+	primary, ok := ve.PrimaryExpression().(*parser.PrimaryExpressionContext)
+	if !ok {
+		return nil, propertyValueError(tt)
+	}
+
+	switch {
+	case primary.Number() != nil:
+		tag := "!!int"
+		if primary.Number().(*parser.NumberContext).DOUBLE_VALUE() != nil {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: sign + primary.GetText()}, nil
+	case sign != "":
+		// only a number takes a sign
+	case primary.StringValue() != nil && primary.Identifier() == nil:
+		value := NewStringValueNode(runtime, primary.StringValue()).Str
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}, nil
+	case primary.BooleanValue() != nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strings.ToLower(primary.GetText())}, nil
+	case primary.QualifiedName() != nil && strings.EqualFold(primary.QualifiedName().GetText(), "ARRAY"):
+		res := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, e := range primary.AllExpression() {
+			value, err := newPropertyValue(runtime, e)
+			if err != nil {
+				return nil, err
+			}
+			res.Content = append(res.Content, value)
+		}
+		return res, nil
+	}
+	return nil, propertyValueError(tt)
+}
+
+func propertyValueError(t *parser.ExpressionContext) error {
+	start := t.GetStart()
+	return fmt.Errorf("line %d:%d  property value %s is not a string, a number, TRUE, FALSE or ARRAY(...)",
+		start.GetLine(), start.GetColumn(), t.GetText())
+}
+
+// RefreshMetadataCommand drops the cached metadata of a catalog, of all catalogs without one.
+type RefreshMetadataCommand struct {
+	Catalog string
+}
+
+func (c *RefreshMetadataCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	connector.RefreshMetadata(c.Catalog)
+	return commandResult("OK")
+}
+
+// CreateCatalogCommand adds a catalog of a connector type to the cluster.
+type CreateCatalogCommand struct {
+	Name        string
+	IfNotExists bool
+	Catalog     *config.Catalog
+}
+
+func (c *CreateCatalogCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	if err := connector.CreateCatalog(c.Name, c.Catalog, c.IfNotExists); err != nil {
+		return nil, nil, err
+	}
+	return commandResult("OK")
+}
+
+// DropCatalogCommand removes a catalog added by CREATE CATALOG.
+type DropCatalogCommand struct {
+	Name     string
+	IfExists bool
+}
+
+func (c *DropCatalogCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	if err := connector.DropCatalog(c.Name, c.IfExists); err != nil {
+		return nil, nil, err
+	}
+	return commandResult("OK")
+}
+
+// CreateTableCommand defines a table of a catalog, LIKE adds the columns of the Like tables.
+type CreateTableCommand struct {
+	Catalog, Schema, Table string
+	IfNotExists            bool
+	Columns                []*metadata.ColumnMetadata
+	// Like holds the table of the LIKE clause at the index of Columns the clause is at
+	Like       map[int]string
+	Properties yaml.Node
+	Runtime    *config.Runtime
+}
+
+func (c *CreateTableCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	md := metadata.NewMetadata()
+	for i := 0; i <= len(c.Columns); i++ {
+		if name, ok := c.Like[i]; ok {
+			catalog, schema, table := metadata.SplitTableName(c.Runtime, name)
+			ctr, err := connector.NewConnector(catalog, schema, table)
+			if err != nil {
+				return nil, nil, err
+			}
+			like, err := ctr.GetMetadata()
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, column := range like.Columns {
+				md.AppendColumn(metadata.NewColumnMetadata(column.ColumnType, c.Catalog, c.Schema, c.Table, column.ColumnName))
+			}
+		}
+		if i < len(c.Columns) {
+			md.AppendColumn(c.Columns[i])
+		}
+	}
+	if err := connector.CreateTable(c.Catalog, c.Schema, c.Table, md, &c.Properties, c.IfNotExists); err != nil {
+		return nil, nil, err
+	}
+	return commandResult("OK")
+}
+
+// DropTableCommand removes a table defined by CREATE TABLE.
+type DropTableCommand struct {
+	Catalog, Schema, Table string
+	IfExists               bool
+}
+
+func (c *DropTableCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	if err := connector.DropTable(c.Catalog, c.Schema, c.Table, c.IfExists); err != nil {
+		return nil, nil, err
+	}
+	return commandResult("OK")
+}
+
+// CreateViewCommand stores a query under a table name, which other queries then read like a
+// subquery.
+type CreateViewCommand struct {
+	Catalog, Schema, Table string
+	OrReplace              bool
+	Query                  string
+	Runtime                *config.Runtime
+}
+
+func (c *CreateViewCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	name := strings.Join([]string{c.Catalog, c.Schema, c.Table}, ".")
+	tree, err := parseQuery(c.Query)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := NewPlanFromQuery(c.Runtime, tree)
+	if usesView(query, name) {
+		return nil, nil, fmt.Errorf("view %s refers to itself", name)
+	}
+	if err = query.SetMetadata(); err != nil {
+		return nil, nil, err
+	}
+	if GetView(name) == nil {
+		if _, err = connector.NewConnector(c.Catalog, c.Schema, c.Table); err == nil {
+			return nil, nil, fmt.Errorf("table %s already exists", name)
+		}
+	}
+
+	view := &View{SQL: c.Query, Catalog: c.Runtime.Catalog, Schema: c.Runtime.Schema}
+	value, err := yaml.Marshal(view)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.OrReplace {
+		err = store.Default.Put(viewPrefix+name, value)
+	} else {
+		var created bool
+		if created, err = store.Default.Create(viewPrefix+name, value); err == nil && !created {
+			err = fmt.Errorf("view %s already exists", name)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	applyView(name, view)
+	return commandResult("OK")
+}
+
+// DropViewCommand removes a view.
+type DropViewCommand struct {
+	Catalog, Schema, Table string
+	IfExists               bool
+}
+
+func (c *DropViewCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	name := strings.Join([]string{c.Catalog, c.Schema, c.Table}, ".")
+	deleted, err := store.Default.Delete(viewPrefix + name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !deleted && !c.IfExists {
+		return nil, nil, fmt.Errorf("view %s does not exist", name)
+	}
+	applyView(name, nil)
+	return commandResult("OK")
+}
+
+// ShowCreateViewCommand returns the statement creating a view.
+type ShowCreateViewCommand struct {
+	Catalog, Schema, Table string
+}
+
+func (c *ShowCreateViewCommand) Run() (*metadata.Metadata, row.Reader, error) {
+	name := strings.Join([]string{c.Catalog, c.Schema, c.Table}, ".")
+	view := GetView(name)
+	if view == nil {
+		return nil, nil, fmt.Errorf("view %s does not exist", name)
+	}
+	return commandResult("CREATE VIEW " + name + " AS\n" + view.SQL)
+}
+
+// commandResult is the single row single column result of a command.
+func commandResult(result string) (*metadata.Metadata, row.Reader, error) {
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "*", "*", "*", "result"))
+	done := false
+	return md, func() (*row.Row, error) {
+		if done {
+			return nil, io.EOF
+		}
+		done = true
+		r := row.NewRow()
+		r.AppendVals(result)
+		return r, nil
+	}, nil
+}
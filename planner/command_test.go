@@ -0,0 +1,296 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/row"
+	"github.com/gotodb/gotodb/store"
+)
+
+func TestRefreshMetadata(t *testing.T) {
+	statement, err := parseStatement("/*+partition_number=2*/ refresh Metadata \"mysql\"")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, ok := NewPlanFromStatement(config.NewRuntime(), statement).(*CommandPlan)
+	if !ok {
+		t.Fatalf("unexpected plan %v", plan)
+	}
+	if c, ok := plan.Command.(*RefreshMetadataCommand); !ok || c.Catalog != "mysql" {
+		t.Fatalf("unexpected command %#v", plan.Command)
+	}
+	if err = plan.SetMetadata(); err != nil || plan.GetMetadata().GetColumnNumber() != 1 {
+		t.Fatalf("unexpected metadata %v, error %v", plan.GetMetadata(), err)
+	}
+
+	if statement, err = parseStatement("select * from test.test.csv"); err != nil {
+		t.Fatal(err)
+	}
+	if plan, ok := NewPlanFromStatement(config.NewRuntime(), statement).(*CommandPlan); ok {
+		t.Fatalf("unexpected plan %v", plan)
+	}
+
+	if _, err = parseStatement("REFRESH\n  TABLES"); err == nil {
+		t.Fatal("REFRESH TABLES is parsed")
+	}
+}
+
+func TestParseCatalogCommands(t *testing.T) {
+	command, err := parseCommand(`CREATE CATALOG IF NOT EXISTS mysql_dev USING mysql
+		WITH (host = '10.0.0.1', port = 3306, "schema" = 'goploy', transaction = true, ratio = -0.5, tags = ARRAY('a', 'b'))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := command.(*CreateCatalogCommand)
+	if !ok || c.Name != "mysql_dev" || !c.IfNotExists || c.Catalog.Type != "mysql" {
+		t.Fatalf("unexpected command %#v", command)
+	}
+	var properties map[string]interface{}
+	if err = c.Catalog.Properties.Decode(&properties); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(properties) != "map[host:10.0.0.1 port:3306 ratio:-0.5 schema:goploy tags:[a b] transaction:true]" {
+		t.Errorf("unexpected properties %v", properties)
+	}
+
+	if command, err = parseCommand("drop catalog if exists mysql_dev"); err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := command.(*DropCatalogCommand); !ok || c.Name != "mysql_dev" || !c.IfExists {
+		t.Fatalf("unexpected command %#v", command)
+	}
+
+	if _, err = parseCommand("CREATE CATALOG c USING file WITH (paths = a + 1)"); err == nil ||
+		!strings.HasPrefix(err.Error(), "line 1:42  property value a+1 ") {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err = parseStatement("drop catalog if exists"); err == nil {
+		t.Error("DROP CATALOG without a name is parsed")
+	}
+}
+
+func TestParseTableCommands(t *testing.T) {
+	command, err := parseCommand(`CREATE TABLE IF NOT EXISTS logs.events (
+		id BIGINT, name VARCHAR(20) COMMENT 'user name', LIKE file.logs.base EXCLUDING PROPERTIES, score double precision, dt DATE
+	) COMMENT 'events' WITH (format = 'csv', location = '/data/events', partitioned_by = ARRAY('dt'))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := command.(*CreateTableCommand)
+	if !ok || c.Catalog != "default" || c.Schema != "logs" || c.Table != "events" || !c.IfNotExists || c.Like[2] != "file.logs.base" {
+		t.Fatalf("unexpected command %#v", command)
+	}
+	var columns []string
+	for _, column := range c.Columns {
+		columns = append(columns, column.ColumnName+" "+column.ColumnType.String())
+	}
+	if fmt.Sprint(columns) != "[id INT64 name STRING score FLOAT64 dt DATE]" {
+		t.Errorf("unexpected columns %v", columns)
+	}
+
+	if command, err = parseCommand("drop table file.logs.events"); err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := command.(*DropTableCommand); !ok || c.Catalog != "file" || c.Schema != "logs" || c.Table != "events" || c.IfExists {
+		t.Fatalf("unexpected command %#v", command)
+	}
+
+	if _, err = parseCommand("create table t (id int, tags list)"); err == nil || err.Error() != "line 1:29  unknown type list" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err = parseCommand("create table t (LIKE file.logs.base INCLUDING PROPERTIES)"); err == nil ||
+		err.Error() != "line 1:36  INCLUDING PROPERTIES is not supported" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCreateTableAs(t *testing.T) {
+	defaultStore := store.Default
+	store.Default = store.NewMemory()
+	defer func() {
+		_ = connector.DropTable("file", "logs", "copy", true)
+		store.Default = defaultStore
+	}()
+
+	location := t.TempDir() + "/copy"
+	statement, err := parseStatement(fmt.Sprintf(
+		"create table file.logs.copy with (location = '%s') as select var1, var2 as score from test.test.csv", location))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := NewPlanFromStatement(config.NewRuntime(), statement)
+	insert, ok := plan.(*InsertPlan)
+	if !ok || insert.Name != "file.logs.copy" || insert.Create == nil || insert.Input.GetOutput() != plan {
+		t.Fatalf("unexpected plan %v", plan)
+	}
+	if err = plan.SetMetadata(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = insert.CreateTable(func() (*metadata.Metadata, row.Reader, error) {
+		return nil, nil, fmt.Errorf("read md err: EOF")
+	}); err == nil || err.Error() != "read md err: EOF" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err = connector.NewConnector("file", "logs", "copy"); err == nil {
+		t.Fatal("table file.logs.copy is left after the insert failed")
+	}
+
+	md, reader, err := insert.CreateTable(func() (*metadata.Metadata, row.Reader, error) {
+		return commandResult("2")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := reader(); err != nil || md.GetColumnNumber() != 1 || fmt.Sprint(r.Vals) != "[2]" {
+		t.Errorf("unexpected result %v, error %v", r, err)
+	}
+	ctr, err := connector.NewConnector("file", "logs", "copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md, err = ctr.GetMetadata(); err != nil {
+		t.Fatal(err)
+	}
+	var columns []string
+	for _, column := range md.Columns {
+		columns = append(columns, column.ColumnName+" "+column.ColumnType.String())
+	}
+	if fmt.Sprint(columns) != "[var1 INT64 score FLOAT64]" {
+		t.Errorf("unexpected columns %v", columns)
+	}
+
+	notRun := func() (*metadata.Metadata, row.Reader, error) {
+		t.Fatal("insert runs for a table that exists")
+		return nil, nil, nil
+	}
+	if _, _, err = insert.CreateTable(notRun); err == nil || err.Error() != "table file.logs.copy already exists" {
+		t.Errorf("unexpected error %v", err)
+	}
+	insert.Create.IfNotExists = true
+	if _, _, err = insert.CreateTable(notRun); err != nil {
+		t.Error(err)
+	}
+
+	if statement, err = parseStatement(fmt.Sprintf(
+		"create table file.logs.broken with (location = '%s') as select unknown from test.test.csv", location)); err != nil {
+		t.Fatal(err)
+	}
+	if err = NewPlanFromStatement(config.NewRuntime(), statement).SetMetadata(); err == nil {
+		t.Error("a query of an unknown column is planned")
+	}
+	if _, err = connector.NewConnector("file", "logs", "broken"); err == nil {
+		t.Error("table file.logs.broken is created by a query that failed")
+	}
+
+	if _, err = parseStatement("create table t with (format = 'csv')"); err == nil {
+		t.Error("CREATE TABLE without columns or a query is parsed")
+	}
+}
+
+func TestViews(t *testing.T) {
+	defaultStore := store.Default
+	store.Default = store.NewMemory()
+	defer func() {
+		store.Default = defaultStore
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := WatchViews(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(sqlStr string) error {
+		command, err := parseCommand(sqlStr)
+		if err != nil {
+			return err
+		}
+		_, _, err = command.Run()
+		return err
+	}
+	if err := run("create view reports.sales.v1 as select var1, var2 as score from test.test.csv where var1 > 1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := run("create view reports.sales.v1 as select var1 from test.test.csv"); err == nil || err.Error() != "view reports.sales.v1 already exists" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := run("create view reports.sales.v2 as SELECT v1.score FROM reports.sales.v1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := run("create or replace view reports.sales.v1 as select score from reports.sales.v2"); err == nil || err.Error() != "view reports.sales.v1 refers to itself" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	tree, err := parseQuery("select score from reports.sales.v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := NewPlanFromQuery(config.NewRuntime(), tree)
+	if err = plan.SetMetadata(); err != nil {
+		t.Fatal(err)
+	}
+	if !usesView(plan, "reports.sales.v1") || !usesView(plan, "reports.sales.v2") {
+		t.Errorf("views are not expanded in %v", plan)
+	}
+	if md := plan.GetMetadata(); md.GetColumnNumber() != 1 || md.Columns[0].ColumnName != "score" {
+		t.Errorf("unexpected metadata %v", md)
+	}
+
+	command, err := parseCommand("show create view reports.sales.v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, reader, err := command.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := reader()
+	if err != nil || r.Vals[0] != "CREATE VIEW reports.sales.v2 AS\nSELECT v1.score FROM reports.sales.v1" {
+		t.Errorf("unexpected row %v, error %v", r, err)
+	}
+
+	applyView("reports.sales.broken", &View{SQL: "select from"})
+	if tree, err = parseQuery("select * from reports.sales.broken"); err != nil {
+		t.Fatal(err)
+	}
+	if err = NewPlanFromQuery(config.NewRuntime(), tree).SetMetadata(); err == nil ||
+		!strings.HasPrefix(err.Error(), "view reports.sales.broken: ") {
+		t.Errorf("unexpected error %v", err)
+	}
+	applyView("reports.sales.broken", nil)
+
+	if err = run("drop view reports.sales.v2"); err != nil {
+		t.Fatal(err)
+	}
+	if GetView("reports.sales.v2") != nil {
+		t.Errorf("view reports.sales.v2 is not dropped")
+	}
+	if err = run("drop view reports.sales.v2"); err == nil || err.Error() != "view reports.sales.v2 does not exist" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err = run("drop view if exists reports.sales.v2"); err != nil {
+		t.Error(err)
+	}
+	if err = run("drop view reports.sales.v1"); err != nil {
+		t.Error(err)
+	}
+}
+
+// parseCommand returns the command of a statement the coordinator runs by itself.
+func parseCommand(sqlStr string) (Command, error) {
+	statement, err := parseStatement(sqlStr)
+	if err != nil {
+		return nil, err
+	}
+	plan, ok := NewPlanFromStatement(config.NewRuntime(), statement).(*CommandPlan)
+	if !ok {
+		return nil, fmt.Errorf("%s is no command", sqlStr)
+	}
+	return plan.Command, plan.Err
+}
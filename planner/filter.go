@@ -0,0 +1,210 @@
+package planner
+
+import (
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/filter"
+	"github.com/gotodb/gotodb/metadata"
+)
+
+// ToPredicate converts a filter on the columns of md into a predicate for the connectors, nil if it
+// uses anything but columns, literals and the predicates a filter.Predicate can express.
+func (n *BooleanExpressionNode) ToPredicate(md *metadata.Metadata) *filter.Predicate {
+	switch {
+	case n.Predicated != nil:
+		return n.Predicated.toPredicate(md)
+	case n.NotBooleanExpression != nil:
+		if child := n.NotBooleanExpression.BooleanExpression.ToPredicate(md); child != nil {
+			return filter.NewNot(child)
+		}
+	case n.BinaryBooleanExpression != nil:
+		left := n.BinaryBooleanExpression.LeftBooleanExpression.ToPredicate(md)
+		right := n.BinaryBooleanExpression.RightBooleanExpression.ToPredicate(md)
+		if left == nil || right == nil {
+			return nil
+		}
+		if *n.BinaryBooleanExpression.Operator == datatype.AND {
+			return filter.NewAnd(left, right)
+		}
+		return filter.NewOr(left, right)
+	}
+	return nil
+}
+
+func (n *PredicatedNode) toPredicate(md *metadata.Metadata) *filter.Predicate {
+	p := n.Predicate
+	if p == nil {
+		if primary := n.ValueExpression.PrimaryExpression; n.ValueExpression.Operator == nil &&
+			primary != nil && primary.ParenthesizedExpression != nil {
+			return primary.ParenthesizedExpression.BooleanExpression.ToPredicate(md)
+		}
+		return nil
+	}
+
+	column := predicateColumn(n.ValueExpression, md)
+	var res *filter.Predicate
+	switch p.Type {
+	case PredicateTypeComparison:
+		op := *p.ComparisonOperator
+		if column == "" {
+			// literal op column
+			column = predicateColumn(p.FirstValueExpression, md)
+			value := n.ValueExpression.GetLiteral()
+			if column == "" || value == nil {
+				return nil
+			}
+			switch op {
+			case datatype.LT:
+				op = datatype.GT
+			case datatype.LTE:
+				op = datatype.GTE
+			case datatype.GT:
+				op = datatype.LT
+			case datatype.GTE:
+				op = datatype.LTE
+			}
+			return filter.NewCompare(column, op, value)
+		}
+		value := p.FirstValueExpression.GetLiteral()
+		if value == nil {
+			return nil
+		}
+		return filter.NewCompare(column, op, value)
+
+	case PredicateTypeBetween:
+		lower, upper := p.FirstValueExpression.GetLiteral(), p.SecondValueExpression.GetLiteral()
+		if column == "" || lower == nil || upper == nil {
+			return nil
+		}
+		res = filter.NewBetween(column, lower, upper)
+
+	case PredicateTypeIn:
+		if column == "" {
+			return nil
+		}
+		values := make([]interface{}, len(p.BooleanExpressionNodes))
+		for i, be := range p.BooleanExpressionNodes {
+			if be.Predicated == nil || be.Predicated.Predicate != nil {
+				return nil
+			}
+			if values[i] = be.Predicated.ValueExpression.GetLiteral(); values[i] == nil {
+				return nil
+			}
+		}
+		res = filter.NewIn(column, values...)
+
+	case PredicateTypeLike:
+		pattern, ok := p.FirstValueExpression.GetLiteral().(string)
+		if column == "" || !ok {
+			return nil
+		}
+		var escape *string
+		if p.SecondValueExpression != nil {
+			e, ok := p.SecondValueExpression.GetLiteral().(string)
+			if !ok {
+				return nil
+			}
+			escape = &e
+		}
+		res = filter.NewLike(column, pattern, escape)
+
+	case PredicateTypeIsNull:
+		if column == "" {
+			return nil
+		}
+		res = filter.NewIsNull(column)
+
+	default:
+		return nil
+	}
+
+	if p.IsNot {
+		res = filter.NewNot(res)
+	}
+	return res
+}
+
+func predicateColumn(n *ValueExpressionNode, md *metadata.Metadata) string {
+	index, err := md.GetIndexByName(n.GetColumnName())
+	if err != nil {
+		return ""
+	}
+	return md.Columns[index].ColumnName
+}
+
+// GetColumnName returns the name of the column an expression only references, "" for any other expression.
+func (n *ExpressionNode) GetColumnName() string {
+	if value := n.valueExpression(); value != nil {
+		return value.GetColumnName()
+	}
+	return ""
+}
+
+// GetLiteral returns the number, string or boolean an expression is, nil for any other expression.
+func (n *ExpressionNode) GetLiteral() interface{} {
+	if value := n.valueExpression(); value != nil {
+		return value.GetLiteral()
+	}
+	return nil
+}
+
+func (n *ExpressionNode) valueExpression() *ValueExpressionNode {
+	if n == nil || n.BooleanExpression == nil || n.BooleanExpression.Predicated == nil ||
+		n.BooleanExpression.Predicated.Predicate != nil {
+		return nil
+	}
+	return n.BooleanExpression.Predicated.ValueExpression
+}
+
+// GetColumnName returns the name of the column a value only references, "" for any other value.
+func (n *ValueExpressionNode) GetColumnName() string {
+	primary := n.PrimaryExpression
+	switch {
+	case n.Operator != nil || primary == nil:
+		return ""
+	case primary.Identifier != nil && primary.StringValue == nil:
+		return primary.Identifier.GetText()
+	case primary.Base != nil && primary.Base.Identifier != nil && primary.Base.StringValue == nil:
+		return primary.Name
+	case primary.ParenthesizedExpression != nil:
+		return primary.ParenthesizedExpression.GetColumnName()
+	}
+	return ""
+}
+
+// GetLiteral returns the number, string or boolean a value is, nil for any other value.
+func (n *ValueExpressionNode) GetLiteral() interface{} {
+	if n.Operator != nil {
+		v := n.ValueExpression.GetLiteral()
+		if *n.Operator != datatype.MINUS {
+			switch v.(type) {
+			case int64, float64:
+				return v
+			}
+			return nil
+		}
+		switch tv := v.(type) {
+		case int64:
+			return -tv
+		case float64:
+			return -tv
+		}
+		return nil
+	}
+
+	primary := n.PrimaryExpression
+	switch {
+	case primary == nil:
+		return nil
+	case primary.Number != nil && primary.Number.IntVal != nil:
+		return *primary.Number.IntVal
+	case primary.Number != nil && primary.Number.DoubleVal != nil:
+		return *primary.Number.DoubleVal
+	case primary.StringValue != nil && primary.Identifier == nil:
+		return primary.StringValue.Str
+	case primary.BooleanValue != nil:
+		return primary.BooleanValue.Bool
+	case primary.ParenthesizedExpression != nil:
+		return primary.ParenthesizedExpression.GetLiteral()
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package planner
+
+import (
+	"testing"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+	parser2 "github.com/gotodb/gotodb/pkg/parser"
+)
+
+func TestToPredicate(t *testing.T) {
+	md := metadata.NewMetadata()
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.INT64, "test", "test", "t", "id"))
+	md.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "test", "test", "t", "name"))
+
+	cases := map[string]string{
+		"id >= -3":                    "id >= -3",
+		"2.5 > t.id":                  "id < 2.5",
+		"NOT (id = 1 OR name <> 'a')": "NOT (id = 1 OR name <> 'a')",
+		"id NOT BETWEEN 1 AND 2 AND name IS NULL":         "(NOT id BETWEEN 1 AND 2 AND name IS NULL)",
+		"id IN (1, 2) AND name NOT LIKE 'a!%' ESCAPE '!'": "(id IN (1, 2) AND NOT name LIKE 'a!%' ESCAPE '!')",
+		"name IS NOT NULL":      "NOT name IS NULL",
+		"id + 1 > 2":            "",
+		"id IS DISTINCT FROM 1": "",
+		"name = id":             "",
+		"other = 1":             "",
+	}
+	for sqlStr, expected := range cases {
+		p := parser2.NewSqlParser(antlr.NewCommonTokenStream(parser2.NewSqlLexer(parser2.NewCaseChangingStream(antlr.NewInputStream(sqlStr), true)), antlr.TokenDefaultChannel))
+		predicate := NewBooleanExpressionNode(config.NewRuntime(), p.BooleanExpression()).ToPredicate(md)
+		if predicate == nil && expected != "" || predicate != nil && predicate.String() != expected {
+			t.Errorf("%s: expected %q, got %v", sqlStr, expected, predicate)
+		}
+	}
+}
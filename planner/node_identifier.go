@@ -108,6 +108,8 @@ func (n *IdentifierNode) GetText() string {
 		return *n.Str
 	} else if n.Digit != nil {
 		return fmt.Sprintf("%d", *n.Digit)
+	} else if n.NonReserved != nil {
+		return *n.NonReserved
 	}
 	return ""
 }
@@ -72,7 +72,7 @@ func (n *ValueExpressionNode) GetColumns() ([]string, error) {
 	if n.PrimaryExpression != nil {
 		return n.PrimaryExpression.GetColumns()
 	} else if n.ValueExpression != nil {
-		return n.PrimaryExpression.GetColumns()
+		return n.ValueExpression.GetColumns()
 	} else if n.BinaryValueExpression != nil {
 		return n.BinaryValueExpression.GetColumns()
 	}
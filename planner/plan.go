@@ -31,6 +31,7 @@ const (
 	NodeTypeDistinctGlobal
 	NodeTypeUse
 	NodeTypeShow
+	NodeTypeCommand
 )
 
 type Plan interface {
@@ -54,10 +55,18 @@ func NewPlanFromSingleStatement(runtime *config.Runtime, t parser.ISingleStateme
 func NewPlanFromStatement(runtime *config.Runtime, t parser.IStatementContext) Plan {
 	tt := t.(*parser.StatementContext)
 
+	if command, err := NewCommandFromStatement(runtime, tt); command != nil || err != nil {
+		return NewCommandPlan(runtime, command, err)
+	}
+
 	if tt.INSERT() != nil {
 		return NewPlanFromInsert(runtime, tt)
 	}
 
+	if tt.CREATE() != nil && tt.TABLE() != nil && tt.Query() != nil {
+		return NewPlanFromCreateTableAs(runtime, tt)
+	}
+
 	if tt.Query() != nil {
 		return NewPlanFromQuery(runtime, tt.Query())
 	}
@@ -182,6 +191,34 @@ func NewPlanFromInsert(runtime *config.Runtime, t parser.IStatementContext) Plan
 	return res
 }
 
+// NewPlanFromCreateTableAs returns the plan inserting the rows of the query into the table, which
+// is created with the columns of the query when the plan runs.
+func NewPlanFromCreateTableAs(runtime *config.Runtime, t parser.IStatementContext) Plan {
+	properties, err := NewProperties(runtime, t.Properties())
+	if err != nil {
+		return NewCommandPlan(runtime, nil, err)
+	}
+
+	var res Plan
+
+	queryNode := NewPlanFromQuery(runtime, t.Query())
+	res = queryNode
+
+	insertNode := NewInsertPlan(runtime, res, t.QualifiedName(), nil)
+	insertNode.Create = &CreateTableCommand{
+		Catalog:     insertNode.Catalog,
+		Schema:      insertNode.Schema,
+		Table:       insertNode.Table,
+		IfNotExists: t.EXISTS() != nil,
+		Properties:  properties,
+		Runtime:     runtime,
+	}
+	res.SetOutput(insertNode)
+	res = insertNode
+
+	return res
+}
+
 func NewPlanFromQuery(runtime *config.Runtime, t parser.IQueryContext) Plan {
 	tt := t.(*parser.QueryContext)
 	var res Plan
@@ -283,6 +320,13 @@ func NewPlanFromRelationPrimary(runtime *config.Runtime, t parser.IRelationPrima
 	if tn := tt.QualifiedName(); tn != nil {
 		ttn := tn.(*parser.QualifiedNameContext)
 		qname := ttn.GetText()
+		view, err := NewPlanFromView(runtime, qname)
+		if err != nil {
+			return &RenamePlan{Rename: qname, View: qname, Metadata: metadata.NewMetadata(), Err: err}
+		}
+		if view != nil {
+			return view
+		}
 		return NewScanPlan(runtime, qname)
 
 	} else if tq := tt.Query(); tq != nil {
@@ -0,0 +1,65 @@
+package planner
+
+import (
+	"fmt"
+
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/datatype"
+	"github.com/gotodb/gotodb/metadata"
+)
+
+// CommandPlan is a statement the coordinator runs by itself instead of sending it to the workers.
+type CommandPlan struct {
+	Command  Command
+	Metadata *metadata.Metadata
+	// Err is the error building the command from the statement, SetMetadata returns it
+	Err error
+}
+
+func NewCommandPlan(_ *config.Runtime, command Command, err error) *CommandPlan {
+	return &CommandPlan{
+		Command:  command,
+		Metadata: metadata.NewMetadata(),
+		Err:      err,
+	}
+}
+
+func (n *CommandPlan) GetType() NodeType {
+	return NodeTypeCommand
+}
+
+func (n *CommandPlan) SetMetadata() error {
+	if n.Err != nil {
+		return n.Err
+	}
+	n.Metadata = metadata.NewMetadata()
+	n.Metadata.AppendColumn(metadata.NewColumnMetadata(datatype.STRING, "*", "*", "*", "result"))
+	return nil
+}
+
+func (n *CommandPlan) GetMetadata() *metadata.Metadata {
+	return n.Metadata
+}
+
+func (n *CommandPlan) GetOutput() Plan {
+	return nil
+}
+
+func (n *CommandPlan) SetOutput(_ Plan) {
+	return
+}
+
+func (n *CommandPlan) GetInputs() []Plan {
+	return nil
+}
+
+func (n *CommandPlan) SetInputs(_ []Plan) {
+	return
+}
+
+func (n *CommandPlan) String() string {
+	res := "CommandPlan {\n"
+	res += fmt.Sprintf("Command: %T\n", n.Command)
+	res += "}\n"
+	return res
+}
@@ -2,10 +2,15 @@ package planner
 
 import (
 	"fmt"
+	"io"
+
 	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/connector"
 	"github.com/gotodb/gotodb/datatype"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/pkg/parser"
+	"github.com/gotodb/gotodb/row"
+	"github.com/sirupsen/logrus"
 )
 
 type InsertPlan struct {
@@ -17,6 +22,8 @@ type InsertPlan struct {
 	Table    string
 	Name     string
 	Columns  []string
+	// Create is the table of a CREATE TABLE AS, nil for an INSERT
+	Create *CreateTableCommand
 }
 
 func NewInsertPlan(runtime *config.Runtime, input Plan, qualifiedName parser.IQualifiedNameContext, columns parser.IColumnAliasesContext) *InsertPlan {
@@ -72,6 +79,18 @@ func (n *InsertPlan) SetMetadata() error {
 		return err
 	}
 
+	if n.Create != nil {
+		n.Create.Columns = nil
+		names := map[string]bool{}
+		for _, column := range n.Input.GetMetadata().Columns {
+			if names[column.ColumnName] {
+				return fmt.Errorf("column %s is selected twice, give it an alias", column.ColumnName)
+			}
+			names[column.ColumnName] = true
+			n.Create.Columns = append(n.Create.Columns, metadata.NewColumnMetadata(column.ColumnType, n.Catalog, n.Schema, n.Table, column.ColumnName))
+		}
+	}
+
 	n.Metadata = metadata.NewMetadata()
 	col := metadata.NewColumnMetadata(datatype.INT64, n.Catalog, n.Schema, n.Table, "affected")
 	n.Metadata.AppendColumn(col)
@@ -79,6 +98,56 @@ func (n *InsertPlan) SetMetadata() error {
 	return nil
 }
 
+// CreateTable creates the table of a CREATE TABLE AS and fills it by insert, the table is dropped
+// again if insert fails. A table that exists is left as it is if IF NOT EXISTS is set.
+func (n *InsertPlan) CreateTable(insert func() (*metadata.Metadata, row.Reader, error)) (*metadata.Metadata, row.Reader, error) {
+	c := n.Create
+	if _, err := connector.NewConnector(c.Catalog, c.Schema, c.Table); err == nil {
+		if c.IfNotExists {
+			return commandResult("OK")
+		}
+		return nil, nil, fmt.Errorf("table %s already exists", n.Name)
+	}
+	if _, _, err := c.Run(); err != nil {
+		return nil, nil, err
+	}
+
+	md, rows, err := n.readAll(insert)
+	if err != nil {
+		if dropErr := connector.DropTable(c.Catalog, c.Schema, c.Table, true); dropErr != nil {
+			logrus.Errorf("table %s: %v", n.Name, dropErr)
+		}
+		return nil, nil, err
+	}
+	return md, func() (*row.Row, error) {
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+		r := rows[0]
+		rows = rows[1:]
+		return r, nil
+	}, nil
+}
+
+// readAll runs insert and reads its rows, a failing insert may only show when they are read.
+func (n *InsertPlan) readAll(insert func() (*metadata.Metadata, row.Reader, error)) (*metadata.Metadata, []*row.Row, error) {
+	md, reader, err := insert()
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows []*row.Row
+	for {
+		r, err := reader()
+		if err == io.EOF {
+			return md, rows, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, r)
+	}
+}
+
 func (n *InsertPlan) String() string {
 	res := "InsertPlan {\n"
 	res += "Input: " + n.Input.String() + "\n"
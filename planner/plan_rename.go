@@ -6,10 +6,14 @@ import (
 )
 
 type RenamePlan struct {
-	Rename   string
+	Rename string
+	// View is the catalog.schema.table of the view whose query is the input, empty for an alias
+	View     string
 	Metadata *metadata.Metadata
 	Input    Plan
 	Output   Plan
+	// Err is the error of planning the query of the view, SetMetadata returns it
+	Err error
 }
 
 func NewRenamePlan(_ *config.Runtime, input Plan, rename string) *RenamePlan {
@@ -45,6 +49,9 @@ func (n *RenamePlan) GetMetadata() *metadata.Metadata {
 }
 
 func (n *RenamePlan) SetMetadata() (err error) {
+	if n.Err != nil {
+		return n.Err
+	}
 	if err = n.Input.SetMetadata(); err != nil {
 		return err
 	}
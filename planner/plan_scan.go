@@ -16,6 +16,10 @@ type ScanPlan struct {
 	Connector connector.Connector
 	Output    Plan
 	Filters   []*BooleanExpressionNode
+	// PushDown is what the connector computes above the filters, the metadata is then its result
+	PushDown *connector.PushDown
+	// Resolved is the table as the connector resolved it, if it is a connector.Resolver
+	Resolved []byte
 }
 
 func NewScanPlan(runtime *config.Runtime, name string) *ScanPlan {
@@ -74,6 +78,11 @@ func (n *ScanPlan) SetMetadata() error {
 	if err != nil {
 		return err
 	}
+	if resolver, ok := ctr.(connector.Resolver); ok {
+		if n.Resolved, err = resolver.Resolved(); err != nil {
+			return err
+		}
+	}
 	n.Connector = ctr
 	n.Metadata = md.Copy()
 	n.Metadata.Reset()
@@ -0,0 +1,140 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/gotodb/gotodb/config"
+	"github.com/gotodb/gotodb/metadata"
+	"github.com/gotodb/gotodb/pkg/parser"
+	"github.com/gotodb/gotodb/store"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// viewPrefix prefixes the store keys of the views created by CREATE VIEW.
+const viewPrefix = "view/"
+
+// View is a query stored under a table name, the names in the query are resolved with the
+// catalog and schema the view was created in.
+type View struct {
+	SQL     string `yaml:"sql"`
+	Catalog string `yaml:"catalog"`
+	Schema  string `yaml:"schema"`
+}
+
+var (
+	viewLock sync.RWMutex
+	views    = map[string]*View{}
+)
+
+// WatchViews adds the views of the store and follows their changes until ctx is done.
+func WatchViews(ctx context.Context) error {
+	return store.Default.Watch(ctx, viewPrefix, func(key string, value []byte) {
+		name := strings.TrimPrefix(key, viewPrefix)
+		if value == nil {
+			applyView(name, nil)
+			return
+		}
+		var view View
+		if err := yaml.Unmarshal(value, &view); err != nil {
+			logrus.Errorf("view %s: %v", name, err)
+			return
+		}
+		applyView(name, &view)
+	})
+}
+
+// applyView adds, replaces or, if view is nil, removes a view.
+func applyView(name string, view *View) {
+	viewLock.Lock()
+	defer viewLock.Unlock()
+	if view == nil {
+		delete(views, name)
+		return
+	}
+	views[name] = view
+}
+
+// GetView returns the view of a catalog.schema.table name, nil if there is none.
+func GetView(name string) *View {
+	viewLock.RLock()
+	defer viewLock.RUnlock()
+	return views[name]
+}
+
+// NewPlanFromView returns the plan of the query of the view a table name refers to, renamed to
+// the table like an aliased subquery, nil if the name is no view.
+func NewPlanFromView(runtime *config.Runtime, name string) (Plan, error) {
+	catalog, schema, table := metadata.SplitTableName(runtime, name)
+	view := GetView(strings.Join([]string{catalog, schema, table}, "."))
+	if view == nil {
+		return nil, nil
+	}
+	query, err := parseQuery(view.SQL)
+	if err != nil {
+		return nil, fmt.Errorf("view %s: %v", name, err)
+	}
+	viewRuntime := *runtime
+	viewRuntime.Catalog, viewRuntime.Schema = view.Catalog, view.Schema
+
+	res := NewPlanFromQuery(&viewRuntime, query)
+	renameNode := NewRenamePlan(runtime, res, table)
+	renameNode.View = strings.Join([]string{catalog, schema, table}, ".")
+	res.SetOutput(renameNode)
+	return renameNode, nil
+}
+
+// parseStatement parses a single statement.
+func parseStatement(sqlStr string) (*parser.StatementContext, error) {
+	inputStream := antlr.NewInputStream(sqlStr)
+	lexer := parser.NewSqlLexer(parser.NewCaseChangingStream(inputStream, true))
+	p := parser.NewSqlParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	errListener := parser.NewErrorListener()
+	p.AddErrorListener(errListener)
+	tree := p.SingleStatement()
+	if errListener.HasError() {
+		return nil, fmt.Errorf("%v", errListener)
+	}
+	return tree.(*parser.SingleStatementContext).Statement().(*parser.StatementContext), nil
+}
+
+// parseQuery parses a statement that is a query.
+func parseQuery(sqlStr string) (parser.IQueryContext, error) {
+	statement, err := parseStatement(sqlStr)
+	if err != nil {
+		return nil, err
+	}
+	if statement.Query() == nil || statement.INSERT() != nil || statement.CREATE() != nil {
+		return nil, fmt.Errorf("a query expected")
+	}
+	return statement.Query(), nil
+}
+
+// usesView reports whether a plan expands a view.
+func usesView(node Plan, name string) bool {
+	if node == nil {
+		return false
+	}
+	switch node := node.(type) {
+	case *RenamePlan:
+		if node.View == name {
+			return true
+		}
+	case *FilterPlan:
+		for _, be := range node.BooleanExpressions {
+			if be.IsSetSubQuery() && usesView(be.Predicated.Predicate.QueryPlan, name) {
+				return true
+			}
+		}
+	}
+	for _, input := range node.GetInputs() {
+		if usesView(input, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -14,6 +14,9 @@ type InsertJob struct {
 	Table         string
 	Columns       []string
 	Metadata      *metadata.Metadata
+	// Token names the insert of the query and Task this job in it, for connectors staging the rows
+	Token string
+	Task  int
 }
 
 func (n *InsertJob) GetType() JobType {
@@ -32,7 +35,7 @@ func (n *InsertJob) GetLocation() *pb.Location {
 	return n.Location
 }
 
-func NewInsertJob(node *planner.InsertPlan, input, output *pb.Location) *InsertJob {
+func NewInsertJob(node *planner.InsertPlan, input, output *pb.Location, token string, task int) *InsertJob {
 	return &InsertJob{
 		Location: output,
 		Input:    input,
@@ -42,5 +45,7 @@ func NewInsertJob(node *planner.InsertPlan, input, output *pb.Location) *InsertJ
 		Table:    node.Table,
 		Columns:  node.Columns,
 		Metadata: node.GetMetadata(),
+		Token:    token,
+		Task:     task,
 	}
 }
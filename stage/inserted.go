@@ -11,6 +11,10 @@ type InsertedJob struct {
 	Inputs   []*pb.Location
 	Output   *pb.Location
 	Metadata *metadata.Metadata
+	Catalog  string
+	Schema   string
+	Table    string
+	Token    string
 }
 
 func (n *InsertedJob) GetType() JobType {
@@ -29,11 +33,15 @@ func (n *InsertedJob) GetLocation() *pb.Location {
 	return n.Location
 }
 
-func NewInsertedJob(node *planner.InsertPlan, inputs []*pb.Location, output *pb.Location) *InsertedJob {
+func NewInsertedJob(node *planner.InsertPlan, inputs []*pb.Location, output *pb.Location, token string) *InsertedJob {
 	return &InsertedJob{
 		Location: output,
 		Inputs:   inputs,
 		Output:   output,
 		Metadata: node.GetMetadata(),
+		Catalog:  node.Catalog,
+		Schema:   node.Schema,
+		Table:    node.Table,
+		Token:    token,
 	}
 }
@@ -1,6 +1,7 @@
 package stage
 
 import (
+	"github.com/gotodb/gotodb/connector"
 	"github.com/gotodb/gotodb/metadata"
 	"github.com/gotodb/gotodb/partition"
 	"github.com/gotodb/gotodb/pb"
@@ -16,6 +17,8 @@ type ScanJob struct {
 	Partition *partition.Partition
 	Outputs   []*pb.Location
 	Filters   []*planner.BooleanExpressionNode
+	PushDown  *connector.PushDown
+	Resolved  []byte
 }
 
 func (n *ScanJob) GetType() JobType {
@@ -45,5 +48,7 @@ func NewScanJob(node *planner.ScanPlan, par *partition.Partition, loc *pb.Locati
 		Metadata:  node.GetMetadata(),
 		Partition: par,
 		Filters:   node.Filters,
+		PushDown:  node.PushDown,
+		Resolved:  node.Resolved,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/gotodb/gotodb/partition"
 	"github.com/gotodb/gotodb/pb"
 	"github.com/gotodb/gotodb/planner"
+	uuid "github.com/satori/go.uuid"
 )
 
 type JobType int32
@@ -267,10 +268,11 @@ func createJob(inode planner.Plan, jobs *[]Job, executorHeap Worker, pn int) ([]
 			inputs = append(inputs, inputJob.GetOutputs()...)
 		}
 
+		token := uuid.NewV4().String()
 		var localRes []Job
-		for _, input := range inputs {
+		for i, input := range inputs {
 			output := executorHeap.GetExecutorLoc()
-			localRes = append(localRes, NewInsertJob(node, input, output))
+			localRes = append(localRes, NewInsertJob(node, input, output, token, i))
 		}
 
 		inputs = []*pb.Location{}
@@ -278,7 +280,7 @@ func createJob(inode planner.Plan, jobs *[]Job, executorHeap Worker, pn int) ([]
 			inputs = append(inputs, inputJob.GetOutputs()...)
 		}
 		output := executorHeap.GetExecutorLoc()
-		newInsertedJob := NewInsertedJob(node, inputs, output)
+		newInsertedJob := NewInsertedJob(node, inputs, output, token)
 		res = append(res, newInsertedJob)
 
 		*jobs = append(*jobs, localRes...)
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcdTimeout bounds every request to etcd but watches.
+const etcdTimeout = 5 * time.Second
+
+// Etcd is a Store on etcd, the keys are kept under Prefix.
+type Etcd struct {
+	Client *clientv3.Client
+	Prefix string
+}
+
+func NewEtcd(conf clientv3.Config) (*Etcd, error) {
+	cli, err := clientv3.New(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &Etcd{Client: cli, Prefix: "gotodb/"}, nil
+}
+
+func (e *Etcd) Create(key string, value []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	key = e.Prefix + key
+	res, err := e.Client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return res.Succeeded, nil
+}
+
+func (e *Etcd) Put(key string, value []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	_, err := e.Client.Put(ctx, e.Prefix+key, string(value))
+	return err
+}
+
+func (e *Etcd) Delete(key string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	res, err := e.Client.Delete(ctx, e.Prefix+key)
+	if err != nil {
+		return false, err
+	}
+	return res.Deleted > 0, nil
+}
+
+func (e *Etcd) Get(key string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	res, err := e.Client.Get(ctx, e.Prefix+key)
+	if err != nil || len(res.Kvs) == 0 {
+		return nil, err
+	}
+	return res.Kvs[0].Value, nil
+}
+
+func (e *Etcd) Watch(ctx context.Context, prefix string, fn func(key string, value []byte)) error {
+	keys := map[string]bool{}
+	rev, err := e.load(ctx, prefix, keys, fn)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			rev = e.watch(ctx, prefix, rev, keys, fn)
+			if ctx.Err() != nil {
+				return
+			}
+			// the watch was compacted or closed, the keys are read again to catch up on the
+			// changes it missed
+			for {
+				if rev, err = e.load(ctx, prefix, keys, fn); err == nil {
+					break
+				}
+				logrus.Errorf("etcd watch %s: %v", prefix, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Second):
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// load calls fn with the keys with the prefix and their values, and with nil for the keys fn has
+// seen that no longer exist. It returns the revision of the keys.
+func (e *Etcd) load(ctx context.Context, prefix string, keys map[string]bool, fn func(key string, value []byte)) (int64, error) {
+	getCtx, cancel := context.WithTimeout(ctx, etcdTimeout)
+	defer cancel()
+	res, err := e.Client.Get(getCtx, e.Prefix+prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	current := map[string]bool{}
+	for _, kv := range res.Kvs {
+		key := string(kv.Key[len(e.Prefix):])
+		current[key] = true
+		fn(key, kv.Value)
+	}
+	for key := range keys {
+		if !current[key] {
+			fn(key, nil)
+			delete(keys, key)
+		}
+	}
+	for key := range current {
+		keys[key] = true
+	}
+	return res.Header.Revision, nil
+}
+
+// watch calls fn with the changes of the keys with the prefix after the revision until the watch
+// fails or ctx is done, and returns the revision of the last change.
+func (e *Etcd) watch(ctx context.Context, prefix string, rev int64, keys map[string]bool, fn func(key string, value []byte)) int64 {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for wr := range e.Client.Watch(watchCtx, e.Prefix+prefix, clientv3.WithPrefix(), clientv3.WithRev(rev+1)) {
+		if err := wr.Err(); err != nil {
+			logrus.Errorf("etcd watch %s: %v", prefix, err)
+			return rev
+		}
+		for _, ev := range wr.Events {
+			key := string(ev.Kv.Key[len(e.Prefix):])
+			if ev.Type == clientv3.EventTypeDelete {
+				delete(keys, key)
+				fn(key, nil)
+			} else {
+				keys[key] = true
+				fn(key, ev.Kv.Value)
+			}
+		}
+		rev = wr.Header.Revision
+	}
+	if ctx.Err() == nil {
+		logrus.Errorf("etcd watch %s: closed", prefix)
+	}
+	return rev
+}
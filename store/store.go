@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Store keeps the definitions made by statements at runtime, such as catalogs, so that every
+// coordinator and worker of a cluster sees them.
+type Store interface {
+	// Create sets the value of a key unless the key exists and reports whether it did
+	Create(key string, value []byte) (bool, error)
+	Put(key string, value []byte) error
+	// Delete removes a key and reports whether it existed
+	Delete(key string) (bool, error)
+	Get(key string) ([]byte, error)
+	// Watch calls fn with the keys with the prefix and their values and then with every change
+	// of them until ctx is done, the value of a deleted key is nil. It returns once fn has
+	// seen the current keys.
+	Watch(ctx context.Context, prefix string, fn func(key string, value []byte)) error
+}
+
+// Default is the store of the process, a cluster replaces it by one on etcd at startup.
+var Default Store = NewMemory()
+
+type watcher struct {
+	ctx    context.Context
+	prefix string
+	fn     func(key string, value []byte)
+}
+
+// Memory is a Store of one process.
+type Memory struct {
+	lock     sync.Mutex
+	values   map[string][]byte
+	watchers []*watcher
+}
+
+func NewMemory() *Memory {
+	return &Memory{values: map[string][]byte{}}
+}
+
+func (m *Memory) Create(key string, value []byte) (bool, error) {
+	m.lock.Lock()
+	if _, ok := m.values[key]; ok {
+		m.lock.Unlock()
+		return false, nil
+	}
+	m.values[key] = value
+	m.notify(key, value)
+	return true, nil
+}
+
+func (m *Memory) Put(key string, value []byte) error {
+	m.lock.Lock()
+	m.values[key] = value
+	m.notify(key, value)
+	return nil
+}
+
+func (m *Memory) Delete(key string) (bool, error) {
+	m.lock.Lock()
+	if _, ok := m.values[key]; !ok {
+		m.lock.Unlock()
+		return false, nil
+	}
+	delete(m.values, key)
+	m.notify(key, nil)
+	return true, nil
+}
+
+func (m *Memory) Get(key string) ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.values[key], nil
+}
+
+func (m *Memory) Watch(ctx context.Context, prefix string, fn func(key string, value []byte)) error {
+	m.lock.Lock()
+	var keys []string
+	for key := range m.values {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = m.values[key]
+	}
+	m.watchers = append(m.watchers, &watcher{ctx: ctx, prefix: prefix, fn: fn})
+	m.lock.Unlock()
+
+	for i, key := range keys {
+		fn(key, values[i])
+	}
+	return nil
+}
+
+// notify unlocks m and calls the watchers of a changed key.
+func (m *Memory) notify(key string, value []byte) {
+	var fns []func(string, []byte)
+	watchers := m.watchers[:0]
+	for _, w := range m.watchers {
+		if w.ctx.Err() != nil {
+			continue
+		}
+		watchers = append(watchers, w)
+		if strings.HasPrefix(key, w.prefix) {
+			fns = append(fns, w.fn)
+		}
+	}
+	m.watchers = watchers
+	m.lock.Unlock()
+	for _, fn := range fns {
+		fn(key, value)
+	}
+}